@@ -9,7 +9,11 @@ import (
 	"github.com/zeiss/go-acs/sms"
 )
 
-// DefaultVersion
+// DefaultVersion was the API version of all services.
+//
+// Deprecated: Every service has its own default API version, which is set
+// with WithSMSOptions, WithCallOptions or WithIdentityOptions.
+// DefaultVersion is not used anymore.
 var DefaultVersion = struct {
 	APIVersion string `url:"api-version"`
 }{
@@ -21,19 +25,79 @@ type Client struct {
 	SMS      *sms.Service
 	Call     *calls.Service
 	Identity *identities.Service
+
+	signer       carry.SignerProvider
+	retry        RetryPolicy
+	smsOpts      []sms.Opt
+	callOpts     []calls.Opt
+	identityOpts []identities.Opt
+}
+
+// Opt is the option for the client.
+type Opt func(*Client)
+
+// WithSigner sets the signer for the requests.
+// By default requests are signed with the HMAC access key.
+func WithSigner(signer carry.SignerProvider) Opt {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// WithTokenCredential authenticates requests with Microsoft Entra ID bearer tokens
+// instead of the HMAC access key.
+func WithTokenCredential(credential TokenCredential, opts ...BearerTokenOpt) Opt {
+	return WithSigner(NewBearerTokenSigner(credential, opts...))
+}
+
+// WithSMSOptions sets the options for the SMS service.
+func WithSMSOptions(opts ...sms.Opt) Opt {
+	return func(c *Client) {
+		c.smsOpts = append(c.smsOpts, opts...)
+	}
+}
+
+// WithCallOptions sets the options for the call automation service.
+func WithCallOptions(opts ...calls.Opt) Opt {
+	return func(c *Client) {
+		c.callOpts = append(c.callOpts, opts...)
+	}
+}
+
+// WithIdentityOptions sets the options for the identity service.
+func WithIdentityOptions(opts ...identities.Opt) Opt {
+	return func(c *Client) {
+		c.identityOpts = append(c.identityOpts, opts...)
+	}
 }
 
 // New creates a new Client.
-func New(endpointURL, key string, c *http.Client) *Client {
-	base := carry.New().
-		Client(c).
-		Base(endpointURL).
-		QueryStruct(DefaultVersion).
-		SignProvider(carry.NewHMacSigner(key))
-
-	return &Client{
-		SMS:      sms.NewService(base),
-		Identity: identities.NewService(base),
-		Call:     calls.NewService(base),
+func New(endpointURL, key string, c *http.Client, opts ...Opt) *Client {
+	client := &Client{
+		signer: carry.NewHMacSigner(key),
+		retry:  DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	var doer carry.Doer = http.DefaultClient
+	if c != nil {
+		doer = c
 	}
+
+	t := &transport{doer: doer, signer: client.signer, retry: client.retry}
+	base := carry.New().Base(endpointURL)
+
+	client.SMS = sms.NewService(base, append([]sms.Opt{sms.WithDoer(t)}, client.smsOpts...)...)
+	client.Identity = identities.NewService(base, append([]identities.Opt{identities.WithDoer(t)}, client.identityOpts...)...)
+	client.Call = calls.NewService(base, append([]calls.Opt{calls.WithDoer(t)}, client.callOpts...)...)
+
+	return client
+}
+
+// NewWithTokenCredential creates a new Client that authenticates with Microsoft Entra ID.
+func NewWithTokenCredential(endpointURL string, credential TokenCredential, c *http.Client, opts ...Opt) *Client {
+	return New(endpointURL, "", c, append([]Opt{WithTokenCredential(credential)}, opts...)...)
 }
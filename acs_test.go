@@ -0,0 +1,65 @@
+package acs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+	"github.com/zeiss/go-acs/identities"
+	"github.com/zeiss/go-acs/sms"
+)
+
+func TestClient_APIVersion(t *testing.T) {
+	versions := make(chan string, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NotEmpty(t, r.Header.Get("Authorization"))
+
+		versions <- r.URL.Query().Get("api-version")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithCallOptions(calls.WithAPIVersion("2023-10-03")))
+
+	_, err := client.SMS.SendSMS(ctx, &sms.Request{SMSRecipients: []sms.SMSRecipients{{To: "+15550000001"}}})
+	require.NoError(t, err)
+	require.Equal(t, sms.DefaultVersion, <-versions)
+
+	_, err = client.Identity.CreateIdentity(ctx, &identities.CreateIdentityRequestBody{})
+	require.NoError(t, err)
+	require.Equal(t, identities.DefaultVersion, <-versions)
+
+	err = client.Call.CallHangUp(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "2023-10-03", <-versions)
+
+	err = client.Call.CallHangUp(acs.ContextWithAPIVersion(ctx, "2024-09-01-preview"), "test")
+	require.NoError(t, err)
+	require.Equal(t, "2024-09-01-preview", <-versions)
+}
+
+// newCreateCallRequest returns a minimal valid request for creating a call.
+func newCreateCallRequest() *calls.CreateCallRequest {
+	return &calls.CreateCallRequest{
+		CallbackUri: "https://example.com/callback",
+		Targets: []calls.CommunicationIdentifier{
+			{PhoneNumber: &calls.PhonenumberIdentifier{Value: "+14255550123"}},
+		},
+	}
+}
+
+// newPlayRequest returns a minimal valid request for playing media.
+func newPlayRequest() *calls.CallMediaPlayRequest {
+	return &calls.CallMediaPlayRequest{
+		PlaySources: []calls.PlaySource{
+			{Kind: calls.PlaySourceTypeFile, File: &calls.FileSource{URI: "https://example.com/prompt.wav"}},
+		},
+	}
+}
@@ -2,24 +2,64 @@ package calls
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
-	"github.com/go-resty/resty/v2"
 	"github.com/zeiss/carry"
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+	"github.com/zeiss/go-acs/phonenumbers"
 )
 
+// DefaultVersion is the default API version of the call automation service.
+const DefaultVersion = "2024-06-15-preview"
+
+// Version is the API version query of the call automation service.
+type Version struct {
+	APIVersion string `url:"api-version"`
+}
+
 // Service is the service for call.
 type Service struct {
-	client *carry.Client
+	client  *carry.Client
+	doer    carry.Doer
+	version *Version
 }
 
-// NewService returns a new CallService
-func NewService(c *carry.Client) *Service {
-	return &Service{c}
+// Opt is a type for options.
+type Opt func(*Service)
+
+// WithAPIVersion sets the API version of the call automation service.
+func WithAPIVersion(version string) Opt {
+	return func(s *Service) {
+		s.version = &Version{APIVersion: version}
+	}
 }
 
-// Opt is a type for options.
-type Opt func(*resty.Request)
+// WithDoer sets the doer that sends the requests of the call automation service.
+// The client passed to NewService only builds the requests, and its doer is not used.
+// By default the requests are sent with the http.DefaultClient.
+func WithDoer(doer carry.Doer) Opt {
+	return func(s *Service) {
+		s.doer = doer
+	}
+}
+
+// NewService returns a new CallService
+func NewService(c *carry.Client, opts ...Opt) *Service {
+	s := &Service{
+		client:  c,
+		doer:    http.DefaultClient,
+		version: &Version{APIVersion: DefaultVersion},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
 
 // CreateCallRequest is the body for creating a call.
 type CreateCallRequest struct {
@@ -62,7 +102,10 @@ const (
 )
 
 // CreateCallResponse is the response for creating a call.
-type CreateCallResponse struct {
+type CreateCallResponse = CallConnectionProperties
+
+// CallConnectionProperties are the properties of a call connection.
+type CallConnectionProperties struct {
 	// AnsweredBy is the answered by.
 	AnsweredBy CommunicationIdentifier `json:"answeredBy"`
 	// AnsweredFor is the answered for.
@@ -145,10 +188,12 @@ const (
 
 // CommunicationIdentifier is a communication user identifier.
 type CommunicationIdentifier struct {
-	ID                string                 `json:"id,omitempty"`
-	Kind              string                 `json:"kind"`
-	CommunicationUser *CommunicationUser     `json:"communicationUser,omitempty"`
-	PhoneNumber       *PhonenumberIdentifier `json:"phoneNumber,omitempty"`
+	ID                 string                        `json:"id,omitempty"`
+	RawID              string                        `json:"rawId,omitempty"`
+	Kind               CommunicationIdentifierKind   `json:"kind"`
+	CommunicationUser  *CommunicationUserIdentifier  `json:"communicationUser,omitempty"`
+	PhoneNumber        *PhonenumberIdentifier        `json:"phoneNumber,omitempty"`
+	MicrosoftTeamsUser *MicrosoftTeamsUserIdentifier `json:"microsoftTeamsUser,omitempty"`
 }
 
 // CallIntelligenceOptions is the options for call intelligence.
@@ -204,11 +249,26 @@ type PhonenumberIdentifier struct {
 	Value string `json:"value"`
 }
 
-// CommunicationUser is a communication user.
-type CommunicationUser struct {
+// NewPhonenumberIdentifier returns a phone number identifier for the phone number.
+func NewPhonenumberIdentifier(number phonenumbers.E164) *PhonenumberIdentifier {
+	return &PhonenumberIdentifier{Value: number.String()}
+}
+
+// Validate validates the phone number.
+func (p *PhonenumberIdentifier) Validate() error {
+	return phonenumbers.E164(p.Value).Validate()
+}
+
+// CommunicationUserIdentifier is a communication user.
+type CommunicationUserIdentifier struct {
 	ID string `json:"id"`
 }
 
+// CommunicationUser is a communication user.
+//
+// Deprecated: Use CommunicationUserIdentifier or NewCommunicationUser instead.
+type CommunicationUser = CommunicationUserIdentifier
+
 // TranscriptionOptions is the options for transcription.
 type TranscriptionOptions struct {
 	// EnableInterimResults is the flag to enable interim results.
@@ -233,11 +293,42 @@ const (
 	TranscriptionTransportTypeWebsocket TranscriptionTransportType = "websocket"
 )
 
+// Validate validates the request.
+func (r *CreateCallRequest) Validate() error {
+	errs := []error{}
+
+	if r.CallbackUri == "" {
+		errs = append(errs, ErrMissingCallbackURI)
+	}
+
+	if len(r.Targets) == 0 {
+		errs = append(errs, ErrMissingTarget)
+	}
+
+	if r.SourceCallerIdNumber != nil {
+		errs = append(errs, r.SourceCallerIdNumber.Validate())
+	}
+
+	for _, target := range r.Targets {
+		if target.PhoneNumber != nil {
+			errs = append(errs, target.PhoneNumber.Validate())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // CreateCall creates a call.
 func (s *Service) CreateCall(ctx context.Context, body *CreateCallRequest) (*CreateCallResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
 	res := &CreateCallResponse{}
 
-	_, err := s.client.New().Post("/calling/callConnections").BodyJSON(body).ReceiveSuccess(ctx, res)
+	req := repeatability.Set(ctx, s.client.New().Post("/calling/callConnections").QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
 	if err != nil {
 		return nil, err
 	}
@@ -245,9 +336,11 @@ func (s *Service) CreateCall(ctx context.Context, body *CreateCallRequest) (*Cre
 	return res, nil
 }
 
-// CallHangUp is the method for recognizing call.
+// CallHangUp leaves the call. The call continues for the other participants.
 func (s *Service) CallHangUp(ctx context.Context, id string) error {
-	_, err := s.client.New().Delete(fmt.Sprintf("/calling/callConnections/%s", id)).ReceiveSuccess(ctx, nil)
+	req := s.client.New().Delete(fmt.Sprintf("/calling/callConnections/%s", id)).QueryStruct(s.version)
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
 	if err != nil {
 		return err
 	}
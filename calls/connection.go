@@ -0,0 +1,177 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+// CustomCallingContext are the custom headers that are sent with a call.
+type CustomCallingContext struct {
+	// SipHeaders are the custom SIP headers, like "X-MS-Custom-Ticket".
+	SipHeaders map[string]string `json:"sipHeaders,omitempty"`
+	// VoipHeaders are the custom VoIP headers.
+	VoipHeaders map[string]string `json:"voipHeaders,omitempty"`
+}
+
+// TransferToParticipantRequest is the body for transferring a call.
+type TransferToParticipantRequest struct {
+	// TargetParticipant is the participant the call is transferred to.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+	// Transferee is the participant that is transferred, in a call with more than two participants.
+	Transferee *CommunicationIdentifier `json:"transferee,omitempty"`
+	// CustomCallingContext are the custom headers sent to the target.
+	CustomCallingContext *CustomCallingContext `json:"customCallingContext,omitempty"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *TransferToParticipantRequest) Validate() error {
+	errs := []error{}
+
+	switch {
+	case r.TargetParticipant == nil:
+		errs = append(errs, ErrMissingTarget)
+	case r.TargetParticipant.PhoneNumber != nil:
+		errs = append(errs, r.TargetParticipant.PhoneNumber.Validate())
+	}
+
+	if r.Transferee != nil && r.Transferee.PhoneNumber != nil {
+		errs = append(errs, r.Transferee.PhoneNumber.Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+// TransferCallResponse is the response for transferring a call.
+type TransferCallResponse struct {
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// HoldRequest is the body for putting a participant on hold.
+type HoldRequest struct {
+	// TargetParticipant is the participant to put on hold.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+	// PlaySourceInfo is the music that is played while the participant is on hold.
+	PlaySourceInfo *PlaySource `json:"playSourceInfo,omitempty"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *HoldRequest) Validate() error {
+	if r.TargetParticipant == nil {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// UnholdRequest is the body for taking a participant off hold.
+type UnholdRequest struct {
+	// TargetParticipant is the participant to take off hold.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// Validate validates the request.
+func (r *UnholdRequest) Validate() error {
+	if r.TargetParticipant == nil {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// GetCallConnection returns the properties of a call connection.
+func (s *Service) GetCallConnection(ctx context.Context, callConnectionID string) (*CallConnectionProperties, error) {
+	res := &CallConnectionProperties{}
+
+	req := s.client.New().Get(fmt.Sprintf("/calling/callConnections/%s", callConnectionID)).QueryStruct(s.version)
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TerminateCall ends the call for all participants.
+// Use CallHangUp to only leave the call.
+func (s *Service) TerminateCall(ctx context.Context, callConnectionID string) error {
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:terminate", callConnectionID)).QueryStruct(s.version))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferCallToParticipant transfers the call to another participant.
+// The operation context of the request is echoed in the CallTransferAccepted
+// or CallTransferFailed event.
+func (s *Service) TransferCallToParticipant(ctx context.Context, callConnectionID string, body *TransferToParticipantRequest) (*TransferCallResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &TransferCallResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:transferToParticipant", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Hold puts a participant of the call on hold, optionally with hold music.
+// A failure is reported with the HoldFailed event.
+func (s *Service) Hold(ctx context.Context, callConnectionID string, body *HoldRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+	operationContext(&body.OperationContext)
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:hold", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Unhold takes a participant of the call off hold.
+func (s *Service) Unhold(ctx context.Context, callConnectionID string, body *UnholdRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+	operationContext(&body.OperationContext)
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:unhold", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
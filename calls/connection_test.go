@@ -0,0 +1,101 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func newConnectionServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /calling/callConnections/call-1":
+			_ = json.NewEncoder(w).Encode(calls.CallConnectionProperties{
+				CallConnectionId:    "call-1",
+				CallConnectionState: calls.CallConnectionStateConnected,
+				Targets:             []calls.CommunicationIdentifier{*phoneNumber("+14255550123")},
+			})
+		case "POST /calling/callConnections/call-1:terminate":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /calling/callConnections/call-1:transferToParticipant":
+			body := calls.TransferToParticipantRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "+14255550124", body.TargetParticipant.PhoneNumber.Value)
+			require.Equal(t, "+14255550123", body.Transferee.PhoneNumber.Value)
+			require.Equal(t, "12345", body.CustomCallingContext.SipHeaders["X-MS-Custom-Ticket"])
+
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(calls.TransferCallResponse{OperationContext: body.OperationContext})
+		case "POST /calling/callConnections/call-1:hold":
+			body := calls.HoldRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "https://example.com/music.wav", body.PlaySourceInfo.File.URI)
+		case "POST /calling/callConnections/call-1:unhold":
+			body := calls.UnholdRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.NotNil(t, body.TargetParticipant)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestService_GetCallConnection(t *testing.T) {
+	srv := newConnectionServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Call.GetCallConnection(t.Context(), "call-1")
+	require.NoError(t, err)
+	require.Equal(t, calls.CallConnectionStateConnected, res.CallConnectionState)
+	require.Len(t, res.Targets, 1)
+
+	require.NoError(t, client.Call.TerminateCall(t.Context(), "call-1"))
+}
+
+func TestService_TransferCallToParticipant(t *testing.T) {
+	srv := newConnectionServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Call.TransferCallToParticipant(t.Context(), "call-1", &calls.TransferToParticipantRequest{
+		TargetParticipant: phoneNumber("+14255550124"),
+		Transferee:        phoneNumber("+14255550123"),
+		CustomCallingContext: &calls.CustomCallingContext{
+			SipHeaders: map[string]string{"X-MS-Custom-Ticket": "12345"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.OperationContext)
+
+	_, err = client.Call.TransferCallToParticipant(t.Context(), "call-1", &calls.TransferToParticipantRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingTarget)
+}
+
+func TestService_Hold(t *testing.T) {
+	srv := newConnectionServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	err := client.Call.Hold(t.Context(), "call-1", &calls.HoldRequest{
+		TargetParticipant: phoneNumber("+14255550123"),
+		PlaySourceInfo: &calls.PlaySource{
+			Kind: calls.PlaySourceTypeFile,
+			File: &calls.FileSource{URI: "https://example.com/music.wav"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Call.Unhold(t.Context(), "call-1", &calls.UnholdRequest{TargetParticipant: phoneNumber("+14255550123")}))
+
+	require.ErrorIs(t, client.Call.Hold(t.Context(), "call-1", &calls.HoldRequest{}), calls.ErrMissingParticipant)
+}
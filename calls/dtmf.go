@@ -0,0 +1,162 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+// MaxDtmfTones is the maximum number of tones that are sent at once.
+const MaxDtmfTones = 18
+
+var (
+	// ErrMissingTones is returned when a request has no tones.
+	ErrMissingTones = errors.New("calls: tones are required")
+	// ErrTooManyTones is returned when a request has more than MaxDtmfTones tones.
+	ErrTooManyTones = errors.New("calls: too many tones")
+	// ErrInvalidTone is returned when a tone is not a DTMF tone.
+	ErrInvalidTone = errors.New("calls: invalid tone")
+)
+
+// ParseTones parses a digit string, like "1234#", into tones.
+// The letters A to D are case insensitive.
+func ParseTones(s string) (Tones, error) {
+	tones := make(Tones, 0, len(s))
+
+	for _, r := range s {
+		tone, ok := keys[strings.ToUpper(string(r))]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTone, r)
+		}
+
+		tones = append(tones, tone)
+	}
+
+	return tones, nil
+}
+
+// keys maps the characters on a keypad to the tones.
+var keys = func() map[string]Tone {
+	m := make(map[string]Tone, len(digits))
+	for tone, digit := range digits {
+		m[digit] = tone
+	}
+
+	return m
+}()
+
+// SendDtmfTonesRequest is the body for sending DTMF tones.
+type SendDtmfTonesRequest struct {
+	// Tones are the tones to send.
+	Tones Tones `json:"tones"`
+	// TargetParticipant is the participant the tones are sent to.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *SendDtmfTonesRequest) Validate() error {
+	var errs []error
+
+	if len(r.Tones) == 0 {
+		errs = append(errs, ErrMissingTones)
+	}
+
+	if len(r.Tones) > MaxDtmfTones {
+		errs = append(errs, ErrTooManyTones)
+	}
+
+	for _, tone := range r.Tones {
+		if tone.Digit() == "" {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidTone, tone))
+		}
+	}
+
+	if r.TargetParticipant == nil {
+		errs = append(errs, ErrMissingParticipant)
+	}
+
+	return errors.Join(errs...)
+}
+
+// SendDtmfTonesResponse is the response for sending DTMF tones.
+type SendDtmfTonesResponse struct {
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// SendDtmfTones sends DTMF tones to a participant, like to navigate an IVR.
+// The result is reported with the SendDtmfTonesCompleted and SendDtmfTonesFailed events.
+func (s *Service) SendDtmfTones(ctx context.Context, callConnectionID string, body *SendDtmfTonesRequest) (*SendDtmfTonesResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	operationContext(&body.OperationContext)
+
+	res := &SendDtmfTonesResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:sendDtmfTones", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ContinuousDtmfRecognitionRequest is the body for starting and stopping continuous DTMF recognition.
+type ContinuousDtmfRecognitionRequest struct {
+	// TargetParticipant is the participant whose tones are recognized.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *ContinuousDtmfRecognitionRequest) Validate() error {
+	if r.TargetParticipant == nil {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// StartContinuousDtmfRecognition starts recognizing the tones of a participant until it is stopped.
+// Each tone is reported with a ContinuousDtmfRecognitionToneReceived event.
+func (s *Service) StartContinuousDtmfRecognition(ctx context.Context, callConnectionID string, body *ContinuousDtmfRecognitionRequest) error {
+	return s.continuousDtmfRecognition(ctx, callConnectionID, "startContinuousDtmfRecognition", body)
+}
+
+// StopContinuousDtmfRecognition stops recognizing the tones of a participant.
+// It is reported with a ContinuousDtmfRecognitionStopped event.
+func (s *Service) StopContinuousDtmfRecognition(ctx context.Context, callConnectionID string, body *ContinuousDtmfRecognitionRequest) error {
+	return s.continuousDtmfRecognition(ctx, callConnectionID, "stopContinuousDtmfRecognition", body)
+}
+
+func (s *Service) continuousDtmfRecognition(ctx context.Context, callConnectionID, action string, body *ContinuousDtmfRecognitionRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	operationContext(&body.OperationContext)
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:%s", callConnectionID, action)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
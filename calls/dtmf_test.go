@@ -0,0 +1,85 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestParseTones(t *testing.T) {
+	tones, err := calls.ParseTones("0123456789abcd*#")
+	require.NoError(t, err)
+	require.Len(t, tones, 16)
+	require.Equal(t, calls.ToneA, tones[10])
+	require.Equal(t, "0123456789ABCD*#", tones.String())
+
+	_, err = calls.ParseTones("12x")
+	require.ErrorIs(t, err, calls.ErrInvalidTone)
+}
+
+func TestService_SendDtmfTones(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/calling/callConnections/call-1:sendDtmfTones", r.URL.Path)
+
+		body := calls.SendDtmfTonesRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "1234#", body.Tones.String())
+		require.NotEmpty(t, body.OperationContext)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(calls.SendDtmfTonesResponse{OperationContext: body.OperationContext})
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	tones, err := calls.ParseTones("1234#")
+	require.NoError(t, err)
+
+	req := &calls.SendDtmfTonesRequest{Tones: tones, TargetParticipant: phoneNumber("+14255550123")}
+
+	res, err := client.Call.SendDtmfTones(t.Context(), "call-1", req)
+	require.NoError(t, err)
+	require.Equal(t, req.OperationContext, res.OperationContext)
+
+	_, err = client.Call.SendDtmfTones(t.Context(), "call-1", &calls.SendDtmfTonesRequest{Tones: make(calls.Tones, calls.MaxDtmfTones+1)})
+	require.ErrorIs(t, err, calls.ErrTooManyTones)
+	require.ErrorIs(t, err, calls.ErrInvalidTone)
+	require.ErrorIs(t, err, calls.ErrMissingParticipant)
+}
+
+func TestService_ContinuousDtmfRecognition(t *testing.T) {
+	paths := make(chan string, 2)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body := calls.ContinuousDtmfRecognitionRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.NotNil(t, body.TargetParticipant)
+
+		paths <- r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	req := &calls.ContinuousDtmfRecognitionRequest{TargetParticipant: phoneNumber("+14255550123")}
+
+	require.NoError(t, client.Call.StartContinuousDtmfRecognition(t.Context(), "call-1", req))
+	require.Equal(t, "/calling/callConnections/call-1:startContinuousDtmfRecognition", <-paths)
+
+	require.NoError(t, client.Call.StopContinuousDtmfRecognition(t.Context(), "call-1", req))
+	require.Equal(t, "/calling/callConnections/call-1:stopContinuousDtmfRecognition", <-paths)
+
+	err := client.Call.StartContinuousDtmfRecognition(t.Context(), "call-1", &calls.ContinuousDtmfRecognitionRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingParticipant)
+}
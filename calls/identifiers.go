@@ -0,0 +1,89 @@
+package calls
+
+import (
+	"github.com/zeiss/go-acs/phonenumbers"
+)
+
+// CommunicationIdentifierKind is the kind of the communication identifier.
+type CommunicationIdentifierKind string
+
+const (
+	// CommunicationIdentifierKindCommunicationUser is the communication user kind.
+	CommunicationIdentifierKindCommunicationUser CommunicationIdentifierKind = "communicationUser"
+	// CommunicationIdentifierKindPhoneNumber is the phone number kind.
+	CommunicationIdentifierKindPhoneNumber CommunicationIdentifierKind = "phoneNumber"
+	// CommunicationIdentifierKindMicrosoftTeamsUser is the Microsoft Teams user kind.
+	CommunicationIdentifierKindMicrosoftTeamsUser CommunicationIdentifierKind = "microsoftTeamsUser"
+	// CommunicationIdentifierKindUnknown is the kind of identifiers that are not known.
+	CommunicationIdentifierKindUnknown CommunicationIdentifierKind = "unknown"
+)
+
+// CommunicationCloudEnvironment is the cloud of a Microsoft Teams user.
+type CommunicationCloudEnvironment string
+
+const (
+	// CommunicationCloudEnvironmentPublic is the public cloud.
+	CommunicationCloudEnvironmentPublic CommunicationCloudEnvironment = "public"
+	// CommunicationCloudEnvironmentDod is the US Department of Defense cloud.
+	CommunicationCloudEnvironmentDod CommunicationCloudEnvironment = "dod"
+	// CommunicationCloudEnvironmentGcch is the US Government Community Cloud High.
+	CommunicationCloudEnvironmentGcch CommunicationCloudEnvironment = "gcch"
+)
+
+// rawIDPrefixes are the prefixes of the raw ids of Microsoft Teams users per cloud.
+var rawIDPrefixes = map[CommunicationCloudEnvironment]string{
+	CommunicationCloudEnvironmentPublic: "8:orgid:",
+	CommunicationCloudEnvironmentDod:    "8:dod:",
+	CommunicationCloudEnvironmentGcch:   "8:gcch:",
+}
+
+// MicrosoftTeamsUserIdentifier is a Microsoft Teams user.
+type MicrosoftTeamsUserIdentifier struct {
+	// UserID is the Entra ID object id of the user.
+	UserID string `json:"userId"`
+	// IsAnonymous is true if the user is an anonymous guest of a meeting.
+	IsAnonymous bool `json:"isAnonymous,omitempty"`
+	// Cloud is the cloud of the user.
+	Cloud CommunicationCloudEnvironment `json:"cloud,omitempty"`
+}
+
+// NewPhoneNumber returns the identifier of a phone number.
+func NewPhoneNumber(number phonenumbers.E164) *CommunicationIdentifier {
+	return &CommunicationIdentifier{
+		RawID:       "4:" + number.String(),
+		Kind:        CommunicationIdentifierKindPhoneNumber,
+		PhoneNumber: NewPhonenumberIdentifier(number),
+	}
+}
+
+// NewCommunicationUser returns the identifier of a communication user, like "8:acs:...".
+func NewCommunicationUser(id string) *CommunicationIdentifier {
+	return &CommunicationIdentifier{
+		RawID:             id,
+		Kind:              CommunicationIdentifierKindCommunicationUser,
+		CommunicationUser: &CommunicationUserIdentifier{ID: id},
+	}
+}
+
+// NewTeamsUser returns the identifier of a Microsoft Teams user by the Entra ID object id.
+// An empty cloud is the public cloud. The raw id is left empty for a cloud that is not known,
+// instead of guessing the cloud of the user.
+func NewTeamsUser(id string, cloud CommunicationCloudEnvironment) *CommunicationIdentifier {
+	if cloud == "" {
+		cloud = CommunicationCloudEnvironmentPublic
+	}
+
+	rawID := ""
+	if prefix, ok := rawIDPrefixes[cloud]; ok {
+		rawID = prefix + id
+	}
+
+	return &CommunicationIdentifier{
+		RawID: rawID,
+		Kind:  CommunicationIdentifierKindMicrosoftTeamsUser,
+		MicrosoftTeamsUser: &MicrosoftTeamsUserIdentifier{
+			UserID: id,
+			Cloud:  cloud,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestIdentifiers(t *testing.T) {
+	tests := []struct {
+		name string
+		id   *calls.CommunicationIdentifier
+		json string
+	}{
+		{
+			name: "phone number",
+			id:   calls.NewPhoneNumber("+14255550123"),
+			json: `{"rawId":"4:+14255550123","kind":"phoneNumber","phoneNumber":{"id":"","value":"+14255550123"}}`,
+		},
+		{
+			name: "communication user",
+			id:   calls.NewCommunicationUser("8:acs:resource_user"),
+			json: `{"rawId":"8:acs:resource_user","kind":"communicationUser","communicationUser":{"id":"8:acs:resource_user"}}`,
+		},
+		{
+			name: "teams user",
+			id:   calls.NewTeamsUser("00000000-0000-0000-0000-000000000001", ""),
+			json: `{"rawId":"8:orgid:00000000-0000-0000-0000-000000000001","kind":"microsoftTeamsUser","microsoftTeamsUser":{"userId":"00000000-0000-0000-0000-000000000001","cloud":"public"}}`,
+		},
+		{
+			name: "teams user in gcch",
+			id:   calls.NewTeamsUser("00000000-0000-0000-0000-000000000001", calls.CommunicationCloudEnvironmentGcch),
+			json: `{"rawId":"8:gcch:00000000-0000-0000-0000-000000000001","kind":"microsoftTeamsUser","microsoftTeamsUser":{"userId":"00000000-0000-0000-0000-000000000001","cloud":"gcch"}}`,
+		},
+		{
+			name: "teams user in unknown cloud",
+			id:   calls.NewTeamsUser("00000000-0000-0000-0000-000000000001", "azure-china"),
+			json: `{"kind":"microsoftTeamsUser","microsoftTeamsUser":{"userId":"00000000-0000-0000-0000-000000000001","cloud":"azure-china"}}`,
+		},
+		{
+			name: "deprecated communication user",
+			id: &calls.CommunicationIdentifier{
+				Kind:              calls.CommunicationIdentifierKindCommunicationUser,
+				CommunicationUser: &calls.CommunicationUser{ID: "8:acs:resource_user"},
+			},
+			json: `{"kind":"communicationUser","communicationUser":{"id":"8:acs:resource_user"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.id)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.json, string(b))
+		})
+	}
+}
+
+func TestPrompts(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt *calls.PlaySource
+		json   string
+	}{
+		{
+			name:   "text",
+			prompt: calls.TextPrompt("Hello", "en-US-AriaNeural", "en-US"),
+			json:   `{"kind":"text","text":{"text":"Hello","voiceName":"en-US-AriaNeural","sourceLocale":"en-US"}}`,
+		},
+		{
+			name:   "ssml",
+			prompt: calls.SSMLPrompt("<speak>Hello</speak>"),
+			json:   `{"kind":"ssml","ssml":{"ssmlText":"<speak>Hello</speak>"}}`,
+		},
+		{
+			name:   "file",
+			prompt: calls.FilePrompt("https://example.com/hold.wav", "hold"),
+			json:   `{"kind":"file","playSourceCacheId":"hold","file":{"uri":"https://example.com/hold.wav"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.prompt.Validate())
+
+			b, err := json.Marshal(tt.prompt)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.json, string(b))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package calls
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+var (
+	// ErrMissingIncomingCallContext is returned when a request has no incoming call context.
+	ErrMissingIncomingCallContext = errors.New("calls: incoming call context is required")
+	// ErrMissingCallbackURI is returned when a request has no callback uri.
+	ErrMissingCallbackURI = errors.New("calls: callback uri is required")
+	// ErrMissingTarget is returned when a request has no target.
+	ErrMissingTarget = errors.New("calls: target is required")
+)
+
+// AnswerCallRequest is the body for answering a call.
+type AnswerCallRequest struct {
+	// IncomingCallContext is the context of the incoming call.
+	IncomingCallContext string `json:"incomingCallContext"`
+	// CallbackUri is the callback uri.
+	CallbackUri string `json:"callbackUri"`
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+	// CallIntelligenceOptions is the options for call intelligence.
+	CallIntelligenceOptions *CallIntelligenceOptions `json:"callIntelligenceOptions,omitempty"`
+	// AnsweredBy is the communication user that answers the call.
+	AnsweredBy *CommunicationUserIdentifier `json:"answeredBy,omitempty"`
+	// MediaStreamingOptions is the options for media streaming.
+	MediaStreamingOptions *MediaStreamingOptions `json:"mediaStreamingOptions,omitempty"`
+	// TranscriptionOptions is the options for transcription.
+	TranscriptionOptions *TranscriptionOptions `json:"transcriptionOptions,omitempty"`
+}
+
+// Validate validates the request.
+func (r *AnswerCallRequest) Validate() error {
+	errs := []error{}
+
+	if r.IncomingCallContext == "" {
+		errs = append(errs, ErrMissingIncomingCallContext)
+	}
+
+	if r.CallbackUri == "" {
+		errs = append(errs, ErrMissingCallbackURI)
+	}
+
+	return errors.Join(errs...)
+}
+
+// CallRejectReason is the reason for rejecting a call.
+type CallRejectReason string
+
+const (
+	// CallRejectReasonNone rejects the call without a reason.
+	CallRejectReasonNone CallRejectReason = "none"
+	// CallRejectReasonBusy rejects the call as busy.
+	CallRejectReasonBusy CallRejectReason = "busy"
+	// CallRejectReasonForbidden rejects the call as forbidden.
+	CallRejectReasonForbidden CallRejectReason = "forbidden"
+)
+
+// RejectCallRequest is the body for rejecting a call.
+type RejectCallRequest struct {
+	// IncomingCallContext is the context of the incoming call.
+	IncomingCallContext string `json:"incomingCallContext"`
+	// CallRejectReason is the reason for rejecting the call.
+	CallRejectReason CallRejectReason `json:"callRejectReason,omitempty"`
+}
+
+// Validate validates the request.
+func (r *RejectCallRequest) Validate() error {
+	if r.IncomingCallContext == "" {
+		return ErrMissingIncomingCallContext
+	}
+
+	return nil
+}
+
+// RedirectCallRequest is the body for redirecting a call.
+type RedirectCallRequest struct {
+	// IncomingCallContext is the context of the incoming call.
+	IncomingCallContext string `json:"incomingCallContext"`
+	// Target is the target the call is redirected to.
+	Target *CommunicationIdentifier `json:"target"`
+}
+
+// Validate validates the request.
+func (r *RedirectCallRequest) Validate() error {
+	errs := []error{}
+
+	if r.IncomingCallContext == "" {
+		errs = append(errs, ErrMissingIncomingCallContext)
+	}
+
+	switch {
+	case r.Target == nil:
+		errs = append(errs, ErrMissingTarget)
+	case r.Target.PhoneNumber != nil:
+		errs = append(errs, r.Target.PhoneNumber.Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+// AnswerCall answers an incoming call.
+func (s *Service) AnswerCall(ctx context.Context, body *AnswerCallRequest) (*CallConnectionProperties, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := &CallConnectionProperties{}
+
+	req := repeatability.Set(ctx, s.client.New().Post("/calling/callConnections:answer").QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RejectCall rejects an incoming call.
+func (s *Service) RejectCall(ctx context.Context, body *RejectCallRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	req := repeatability.Set(ctx, s.client.New().Post("/calling/callConnections:reject").QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RedirectCall redirects an incoming call to another target.
+func (s *Service) RedirectCall(ctx context.Context, body *RedirectCallRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	req := repeatability.Set(ctx, s.client.New().Post("/calling/callConnections:redirect").QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
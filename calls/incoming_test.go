@@ -0,0 +1,93 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestService_AnswerCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/calling/callConnections:answer", r.URL.Path)
+		require.NotEmpty(t, r.Header.Get(acs.HeaderRepeatabilityRequestID))
+
+		body := calls.AnswerCallRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "context", body.IncomingCallContext)
+		require.Equal(t, "https://example.com/callback", body.CallbackUri)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(calls.CallConnectionProperties{
+			CallConnectionId:    "call-1",
+			CallConnectionState: calls.CallConnectionStateConnecting,
+		})
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Call.AnswerCall(t.Context(), &calls.AnswerCallRequest{
+		IncomingCallContext: "context",
+		CallbackUri:         "https://example.com/callback",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "call-1", res.CallConnectionId)
+
+	_, err = client.Call.AnswerCall(t.Context(), &calls.AnswerCallRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingIncomingCallContext)
+	require.ErrorIs(t, err, calls.ErrMissingCallbackURI)
+}
+
+func TestService_RejectCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/calling/callConnections:reject", r.URL.Path)
+
+		body := calls.RejectCallRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, calls.CallRejectReasonBusy, body.CallRejectReason)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	err := client.Call.RejectCall(t.Context(), &calls.RejectCallRequest{
+		IncomingCallContext: "context",
+		CallRejectReason:    calls.CallRejectReasonBusy,
+	})
+	require.NoError(t, err)
+
+	err = client.Call.RejectCall(t.Context(), &calls.RejectCallRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingIncomingCallContext)
+}
+
+func TestService_RedirectCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/calling/callConnections:redirect", r.URL.Path)
+
+		body := calls.RedirectCallRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "+14255550123", body.Target.PhoneNumber.Value)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	err := client.Call.RedirectCall(t.Context(), &calls.RedirectCallRequest{
+		IncomingCallContext: "context",
+		Target:              phoneNumber("+14255550123"),
+	})
+	require.NoError(t, err)
+
+	err = client.Call.RedirectCall(t.Context(), &calls.RedirectCallRequest{IncomingCallContext: "context"})
+	require.ErrorIs(t, err, calls.ErrMissingTarget)
+}
@@ -0,0 +1,340 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+var (
+	// ErrMissingParticipant is returned when a request has no participant.
+	ErrMissingParticipant = errors.New("calls: participant is required")
+	// ErrMissingInvitationID is returned when a request has no invitation id.
+	ErrMissingInvitationID = errors.New("calls: invitation id is required")
+)
+
+// MaxInvitationTimeoutInSeconds is the maximum time to wait for an invited participant to answer.
+const MaxInvitationTimeoutInSeconds = 180
+
+// NewOperationContext returns a new random operation context.
+func NewOperationContext() string {
+	return uuid.NewString()
+}
+
+// operationContext sets a new operation context if none is set.
+// The operation context is echoed in the events of the operation.
+func operationContext(oc *string) {
+	if *oc == "" {
+		*oc = NewOperationContext()
+	}
+}
+
+// CallParticipant is a participant of a call.
+type CallParticipant struct {
+	// Identifier is the identifier of the participant.
+	Identifier CommunicationIdentifier `json:"identifier"`
+	// IsMuted is true if the participant is muted.
+	IsMuted bool `json:"isMuted"`
+	// IsOnHold is true if the participant is on hold.
+	IsOnHold bool `json:"isOnHold"`
+}
+
+// AddParticipantRequest is the body for adding a participant.
+type AddParticipantRequest struct {
+	// ParticipantToAdd is the participant to add.
+	ParticipantToAdd *CommunicationIdentifier `json:"participantToAdd"`
+	// SourceCallerIdNumber is the caller id number shown to a PSTN participant.
+	SourceCallerIdNumber *PhonenumberIdentifier `json:"sourceCallerIdNumber,omitempty"`
+	// SourceDisplayName is the display name shown to the participant.
+	SourceDisplayName string `json:"sourceDisplayName,omitempty"`
+	// InvitationTimeoutInSeconds is the time to wait for the participant to answer.
+	InvitationTimeoutInSeconds int `json:"invitationTimeoutInSeconds,omitempty"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *AddParticipantRequest) Validate() error {
+	errs := []error{}
+
+	switch {
+	case r.ParticipantToAdd == nil:
+		errs = append(errs, ErrMissingParticipant)
+	case r.ParticipantToAdd.PhoneNumber != nil:
+		errs = append(errs, r.ParticipantToAdd.PhoneNumber.Validate())
+	}
+
+	if r.SourceCallerIdNumber != nil {
+		errs = append(errs, r.SourceCallerIdNumber.Validate())
+	}
+
+	if r.InvitationTimeoutInSeconds < 0 || r.InvitationTimeoutInSeconds > MaxInvitationTimeoutInSeconds {
+		errs = append(errs, fmt.Errorf("calls: invitation timeout must be between 0 and %d seconds", MaxInvitationTimeoutInSeconds))
+	}
+
+	return errors.Join(errs...)
+}
+
+// AddParticipantResponse is the response for adding a participant.
+type AddParticipantResponse struct {
+	// Participant is the added participant.
+	Participant *CallParticipant `json:"participant,omitempty"`
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+	// InvitationID is the ID of the invitation, to cancel adding the participant.
+	InvitationID string `json:"invitationId,omitempty"`
+}
+
+// RemoveParticipantRequest is the body for removing a participant.
+type RemoveParticipantRequest struct {
+	// ParticipantToRemove is the participant to remove.
+	ParticipantToRemove *CommunicationIdentifier `json:"participantToRemove"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *RemoveParticipantRequest) Validate() error {
+	if r.ParticipantToRemove == nil {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// RemoveParticipantResponse is the response for removing a participant.
+type RemoveParticipantResponse struct {
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// CancelAddParticipantRequest is the body for canceling the invitation of a participant.
+type CancelAddParticipantRequest struct {
+	// InvitationID is the ID of the invitation.
+	InvitationID string `json:"invitationId"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+}
+
+// Validate validates the request.
+func (r *CancelAddParticipantRequest) Validate() error {
+	if r.InvitationID == "" {
+		return ErrMissingInvitationID
+	}
+
+	return nil
+}
+
+// CancelAddParticipantResponse is the response for canceling the invitation of a participant.
+type CancelAddParticipantResponse struct {
+	// InvitationID is the ID of the invitation.
+	InvitationID string `json:"invitationId,omitempty"`
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// MuteParticipantsRequest is the body for muting participants.
+type MuteParticipantsRequest struct {
+	// TargetParticipants are the participants to mute.
+	TargetParticipants []CommunicationIdentifier `json:"targetParticipants"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// Validate validates the request.
+func (r *MuteParticipantsRequest) Validate() error {
+	if len(r.TargetParticipants) == 0 {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// MuteParticipantsResponse is the response for muting participants.
+type MuteParticipantsResponse struct {
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// UnmuteParticipantsRequest is the body for unmuting participants.
+type UnmuteParticipantsRequest struct {
+	// TargetParticipants are the participants to unmute.
+	TargetParticipants []CommunicationIdentifier `json:"targetParticipants"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// Validate validates the request.
+func (r *UnmuteParticipantsRequest) Validate() error {
+	if len(r.TargetParticipants) == 0 {
+		return ErrMissingParticipant
+	}
+
+	return nil
+}
+
+// UnmuteParticipantsResponse is the response for unmuting participants.
+type UnmuteParticipantsResponse struct {
+	// OperationContext is the operation context.
+	OperationContext string `json:"operationContext,omitempty"`
+}
+
+// ListParticipantsResponse is a page of participants.
+type ListParticipantsResponse struct {
+	// Value are the participants of the page.
+	Value []CallParticipant `json:"value"`
+	// NextLink is the link to the next page.
+	NextLink string `json:"nextLink,omitempty"`
+}
+
+// AddParticipant adds a participant to the call.
+// The operation context of the request is echoed in the AddParticipantSucceeded
+// or AddParticipantFailed event.
+func (s *Service) AddParticipant(ctx context.Context, callConnectionID string, body *AddParticipantRequest) (*AddParticipantResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &AddParticipantResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s/participants:add", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RemoveParticipant removes a participant from the call.
+// The operation context of the request is echoed in the RemoveParticipantSucceeded
+// or RemoveParticipantFailed event.
+func (s *Service) RemoveParticipant(ctx context.Context, callConnectionID string, body *RemoveParticipantRequest) (*RemoveParticipantResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &RemoveParticipantResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s/participants:remove", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CancelAddParticipant cancels the invitation of a participant.
+// The operation context of the request is echoed in the CancelAddParticipantSucceeded
+// or CancelAddParticipantFailed event.
+func (s *Service) CancelAddParticipant(ctx context.Context, callConnectionID string, body *CancelAddParticipantRequest) (*CancelAddParticipantResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &CancelAddParticipantResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s/participants:cancelAddParticipant", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListParticipants returns all participants of the call.
+// It follows the next links until the last page.
+func (s *Service) ListParticipants(ctx context.Context, callConnectionID string) ([]CallParticipant, error) {
+	participants := []CallParticipant{}
+
+	req := s.client.New().Get(fmt.Sprintf("/calling/callConnections/%s/participants", callConnectionID)).QueryStruct(s.version)
+
+	for {
+		res := &ListParticipantsResponse{}
+
+		_, err := httpx.Receive(ctx, s.doer, req, res)
+		if err != nil {
+			return nil, err
+		}
+
+		participants = append(participants, res.Value...)
+
+		if res.NextLink == "" {
+			return participants, nil
+		}
+
+		// The next link already contains the query of the request.
+		req = s.client.New().Get(res.NextLink)
+	}
+}
+
+// GetParticipant returns a participant of the call by its raw id.
+func (s *Service) GetParticipant(ctx context.Context, callConnectionID, participantRawID string) (*CallParticipant, error) {
+	res := &CallParticipant{}
+
+	req := s.client.New().Get(fmt.Sprintf("/calling/callConnections/%s/participants/%s", callConnectionID, url.PathEscape(participantRawID))).QueryStruct(s.version)
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MuteParticipant mutes participants of the call.
+func (s *Service) MuteParticipant(ctx context.Context, callConnectionID string, body *MuteParticipantsRequest) (*MuteParticipantsResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &MuteParticipantsResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s/participants:mute", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UnmuteParticipant unmutes participants of the call.
+func (s *Service) UnmuteParticipant(ctx context.Context, callConnectionID string, body *UnmuteParticipantsRequest) (*UnmuteParticipantsResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+	operationContext(&body.OperationContext)
+
+	res := &UnmuteParticipantsResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s/participants:unmute", callConnectionID)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
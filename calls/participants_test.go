@@ -0,0 +1,153 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+	"github.com/zeiss/go-acs/phonenumbers"
+)
+
+func newParticipantsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var srv *httptest.Server
+
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "POST /calling/callConnections/call-1/participants:add":
+			require.NotEmpty(t, r.Header.Get(acs.HeaderRepeatabilityRequestID))
+			require.NotEmpty(t, r.Header.Get(acs.HeaderRepeatabilityFirstSent))
+
+			body := calls.AddParticipantRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, 30, body.InvitationTimeoutInSeconds)
+			require.Equal(t, "+14255550100", body.SourceCallerIdNumber.Value)
+
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(calls.AddParticipantResponse{
+				Participant:      &calls.CallParticipant{Identifier: *body.ParticipantToAdd},
+				OperationContext: body.OperationContext,
+				InvitationID:     "invitation-1",
+			})
+		case "POST /calling/callConnections/call-1/participants:remove":
+			body := calls.RemoveParticipantRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(calls.RemoveParticipantResponse{OperationContext: body.OperationContext})
+		case "POST /calling/callConnections/call-1/participants:cancelAddParticipant":
+			body := calls.CancelAddParticipantRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(calls.CancelAddParticipantResponse{InvitationID: body.InvitationID, OperationContext: body.OperationContext})
+		case "POST /calling/callConnections/call-1/participants:mute", "POST /calling/callConnections/call-1/participants:unmute":
+			body := calls.MuteParticipantsRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Len(t, body.TargetParticipants, 1)
+
+			_ = json.NewEncoder(w).Encode(calls.MuteParticipantsResponse{OperationContext: body.OperationContext})
+		case "GET /calling/callConnections/call-1/participants":
+			require.Equal(t, calls.DefaultVersion, r.URL.Query().Get("api-version"))
+
+			res := calls.ListParticipantsResponse{}
+			if r.URL.Query().Get("skipToken") == "" {
+				res.Value = []calls.CallParticipant{{Identifier: calls.CommunicationIdentifier{RawID: "4:+14255550123"}}}
+				res.NextLink = srv.URL + "/calling/callConnections/call-1/participants?api-version=" + calls.DefaultVersion + "&skipToken=2"
+			} else {
+				res.Value = []calls.CallParticipant{{Identifier: calls.CommunicationIdentifier{RawID: "4:+14255550124"}, IsMuted: true}}
+			}
+
+			_ = json.NewEncoder(w).Encode(res)
+		case "GET /calling/callConnections/call-1/participants/4:+14255550123":
+			_ = json.NewEncoder(w).Encode(calls.CallParticipant{Identifier: calls.CommunicationIdentifier{RawID: "4:+14255550123"}, IsOnHold: true})
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func phoneNumber(number string) *calls.CommunicationIdentifier {
+	return calls.NewPhoneNumber(phonenumbers.MustParse(number, ""))
+}
+
+func TestService_AddParticipant(t *testing.T) {
+	srv := newParticipantsServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	body := &calls.AddParticipantRequest{
+		ParticipantToAdd:           phoneNumber("+14255550123"),
+		SourceCallerIdNumber:       calls.NewPhonenumberIdentifier(phonenumbers.MustParse("+14255550100", "")),
+		InvitationTimeoutInSeconds: 30,
+	}
+
+	res, err := client.Call.AddParticipant(t.Context(), "call-1", body)
+	require.NoError(t, err)
+	require.NotEmpty(t, body.OperationContext)
+	require.Equal(t, body.OperationContext, res.OperationContext)
+	require.Equal(t, "invitation-1", res.InvitationID)
+
+	cancel, err := client.Call.CancelAddParticipant(t.Context(), "call-1", &calls.CancelAddParticipantRequest{InvitationID: res.InvitationID, OperationContext: "cancel"})
+	require.NoError(t, err)
+	require.Equal(t, "cancel", cancel.OperationContext)
+
+	_, err = client.Call.AddParticipant(t.Context(), "call-1", &calls.AddParticipantRequest{InvitationTimeoutInSeconds: 300})
+	require.ErrorIs(t, err, calls.ErrMissingParticipant)
+	require.ErrorContains(t, err, "invitation timeout")
+
+	_, err = client.Call.CancelAddParticipant(t.Context(), "call-1", &calls.CancelAddParticipantRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingInvitationID)
+}
+
+func TestService_RemoveParticipant(t *testing.T) {
+	srv := newParticipantsServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Call.RemoveParticipant(t.Context(), "call-1", &calls.RemoveParticipantRequest{ParticipantToRemove: phoneNumber("+14255550123")})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.OperationContext)
+
+	_, err = client.Call.RemoveParticipant(t.Context(), "call-1", &calls.RemoveParticipantRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingParticipant)
+}
+
+func TestService_ListParticipants(t *testing.T) {
+	srv := newParticipantsServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	participants, err := client.Call.ListParticipants(t.Context(), "call-1")
+	require.NoError(t, err)
+	require.Len(t, participants, 2)
+	require.Equal(t, "4:+14255550124", participants[1].Identifier.RawID)
+	require.True(t, participants[1].IsMuted)
+
+	participant, err := client.Call.GetParticipant(t.Context(), "call-1", "4:+14255550123")
+	require.NoError(t, err)
+	require.True(t, participant.IsOnHold)
+}
+
+func TestService_MuteParticipant(t *testing.T) {
+	srv := newParticipantsServer(t)
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	mute, err := client.Call.MuteParticipant(t.Context(), "call-1", &calls.MuteParticipantsRequest{TargetParticipants: []calls.CommunicationIdentifier{*phoneNumber("+14255550123")}})
+	require.NoError(t, err)
+	require.NotEmpty(t, mute.OperationContext)
+
+	unmute, err := client.Call.UnmuteParticipant(t.Context(), "call-1", &calls.UnmuteParticipantsRequest{TargetParticipants: []calls.CommunicationIdentifier{*phoneNumber("+14255550123")}})
+	require.NoError(t, err)
+	require.NotEmpty(t, unmute.OperationContext)
+
+	_, err = client.Call.MuteParticipant(t.Context(), "call-1", &calls.MuteParticipantsRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingParticipant)
+}
@@ -2,7 +2,18 @@ package calls
 
 import (
 	"context"
+	"errors"
 	"fmt"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+var (
+	// ErrMissingPlaySource is returned when a request has no play source.
+	ErrMissingPlaySource = errors.New("calls: play source is required")
+	// ErrInvalidPlaySource is returned when a play source does not match its kind.
+	ErrInvalidPlaySource = errors.New("calls: invalid play source")
 )
 
 // CallMediaPlayRequest is the body for playing media.
@@ -53,9 +64,9 @@ type TextSource struct {
 	// Text is the text.
 	Text string `json:"text"`
 	// VoiceKind is the voice kind.
-	VoiceKind VoiceKind `json:"voiceKind"`
+	VoiceKind VoiceKind `json:"voiceKind,omitempty"`
 	// VoiceName is the voice name.
-	VoiceName string `json:"voiceName"`
+	VoiceName string `json:"voiceName,omitempty"`
 }
 
 // VoiceKind is the kind for voice.
@@ -80,9 +91,136 @@ const (
 	PlaySourceTypeText PlaySourceType = "text"
 )
 
+// TextPrompt returns a play source that speaks the text with the voice, like "en-US-AriaNeural",
+// in the locale, like "en-US". The voice and locale are optional.
+func TextPrompt(text, voice, locale string) *PlaySource {
+	return &PlaySource{
+		Kind: PlaySourceTypeText,
+		TextSource: &TextSource{
+			Text:         text,
+			VoiceName:    voice,
+			SourceLocale: locale,
+		},
+	}
+}
+
+// SSMLPrompt returns a play source that speaks the SSML document.
+func SSMLPrompt(ssml string) *PlaySource {
+	return &PlaySource{
+		Kind:       PlaySourceTypeSSML,
+		SSMLSource: &SSMLSource{SSMLText: ssml},
+	}
+}
+
+// FilePrompt returns a play source that plays the audio file at the uri.
+// The file is cached by the service under the optional cache id.
+func FilePrompt(uri, cacheID string) *PlaySource {
+	return &PlaySource{
+		Kind:              PlaySourceTypeFile,
+		PlaySourceCacheID: cacheID,
+		File:              &FileSource{URI: uri},
+	}
+}
+
+// Validate validates the play source.
+func (p *PlaySource) Validate() error {
+	switch p.Kind {
+	case PlaySourceTypeFile:
+		if p.File == nil || p.File.URI == "" {
+			return fmt.Errorf("%w: file source requires an uri", ErrInvalidPlaySource)
+		}
+	case PlaySourceTypeSSML:
+		if p.SSMLSource == nil || p.SSMLSource.SSMLText == "" {
+			return fmt.Errorf("%w: ssml source requires ssml text", ErrInvalidPlaySource)
+		}
+	case PlaySourceTypeText:
+		if p.TextSource == nil || p.TextSource.Text == "" {
+			return fmt.Errorf("%w: text source requires text", ErrInvalidPlaySource)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidPlaySource, p.Kind)
+	}
+
+	return nil
+}
+
+// Validate validates the request.
+func (r *CallMediaPlayRequest) Validate() error {
+	if len(r.PlaySources) == 0 {
+		return ErrMissingPlaySource
+	}
+
+	var errs []error
+
+	for _, source := range r.PlaySources {
+		errs = append(errs, source.Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
 // CallMediaPlay is the call media play.
 func (s *Service) CallMediaPlay(ctx context.Context, id string, body *CallMediaPlayRequest) error {
-	_, err := s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:play", id)).BodyJSON(body).ReceiveSuccess(ctx, nil)
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	return s.play(ctx, id, body)
+}
+
+// PlayTo plays media to the participants in body.PlayTo.
+// The operation context is generated if it is empty and is echoed in the
+// PlayCompleted, PlayFailed and PlayCanceled events of the operation.
+func (s *Service) PlayTo(ctx context.Context, id string, body *CallMediaPlayRequest) error {
+	errs := []error{body.Validate()}
+
+	if len(body.PlayTo) == 0 {
+		errs = append(errs, ErrMissingTarget)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	operationContext(&body.OperationContext)
+
+	return s.play(ctx, id, body)
+}
+
+// PlayToAll plays media to all participants of the call. body.PlayTo is ignored.
+// The operation context is generated if it is empty and is echoed in the
+// PlayCompleted, PlayFailed and PlayCanceled events of the operation.
+func (s *Service) PlayToAll(ctx context.Context, id string, body *CallMediaPlayRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	operationContext(&body.OperationContext)
+
+	all := *body
+	all.PlayTo = nil
+
+	return s.play(ctx, id, &all)
+}
+
+// play sends the play request. The request has to be validated by the caller.
+func (s *Service) play(ctx context.Context, id string, body *CallMediaPlayRequest) error {
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:play", id)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CancelAllMediaOperations cancels all playing and recognizing of the call, like a looping prompt.
+// The canceled operations raise a PlayCanceled or RecognizeCanceled event with their operation context.
+func (s *Service) CancelAllMediaOperations(ctx context.Context, id string) error {
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:cancelAllMediaOperations", id)).QueryStruct(s.version))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,85 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestService_Play(t *testing.T) {
+	bodies := make(chan calls.CallMediaPlayRequest, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/calling/callConnections/call-1:play", r.URL.Path)
+		require.NotEmpty(t, r.Header.Get(acs.HeaderRepeatabilityRequestID))
+		require.NotEmpty(t, r.Header.Get(acs.HeaderRepeatabilityFirstSent))
+
+		body := calls.CallMediaPlayRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies <- body
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	sources := []calls.PlaySource{{Kind: calls.PlaySourceTypeFile, File: &calls.FileSource{URI: "https://example.com/hold.wav"}}}
+
+	req := &calls.CallMediaPlayRequest{
+		PlaySources: sources,
+		PlayOptions: &calls.PlayOptions{Loop: true},
+		PlayTo:      []calls.CommunicationIdentifier{*phoneNumber("+14255550123")},
+	}
+	require.NoError(t, client.Call.PlayToAll(t.Context(), "call-1", req))
+	require.NotEmpty(t, req.OperationContext)
+
+	body := <-bodies
+	require.Empty(t, body.PlayTo)
+	require.True(t, body.PlayOptions.Loop)
+	require.Equal(t, req.OperationContext, body.OperationContext)
+	require.Len(t, req.PlayTo, 1)
+
+	req = &calls.CallMediaPlayRequest{
+		PlaySources:      sources,
+		OperationContext: "hold-music",
+		PlayTo:           []calls.CommunicationIdentifier{*phoneNumber("+14255550123")},
+	}
+	require.NoError(t, client.Call.PlayTo(t.Context(), "call-1", req))
+
+	body = <-bodies
+	require.Len(t, body.PlayTo, 1)
+	require.Equal(t, "hold-music", body.OperationContext)
+
+	err := client.Call.PlayTo(t.Context(), "call-1", &calls.CallMediaPlayRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingPlaySource)
+	require.ErrorIs(t, err, calls.ErrMissingTarget)
+
+	err = client.Call.PlayToAll(t.Context(), "call-1", &calls.CallMediaPlayRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingPlaySource)
+
+	err = client.Call.CallMediaPlay(t.Context(), "call-1", &calls.CallMediaPlayRequest{
+		PlaySources: []calls.PlaySource{{Kind: calls.PlaySourceTypeFile}, {Kind: "video"}},
+	})
+	require.ErrorIs(t, err, calls.ErrInvalidPlaySource)
+}
+
+func TestService_CancelAllMediaOperations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/calling/callConnections/call-1:cancelAllMediaOperations", r.URL.Path)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	require.NoError(t, client.Call.CancelAllMediaOperations(t.Context(), "call-1"))
+}
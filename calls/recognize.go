@@ -0,0 +1,320 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+const (
+	// MaxInitialSilenceTimeoutInSeconds is the maximum time to wait for the first input.
+	MaxInitialSilenceTimeoutInSeconds = 300
+	// MaxInterDigitTimeoutInSeconds is the maximum time to wait between tones.
+	MaxInterDigitTimeoutInSeconds = 60
+	// MinTonesToCollect is the minimum number of tones to collect.
+	MinTonesToCollect = 1
+	// MaxTonesToCollect is the maximum number of tones to collect.
+	MaxTonesToCollect = 60
+)
+
+var (
+	// ErrMissingRecognizeInputType is returned when a request has no recognize input type.
+	ErrMissingRecognizeInputType = errors.New("calls: recognize input type is required")
+	// ErrInvalidRecognizeInputType is returned when the recognize input type does not match the options.
+	ErrInvalidRecognizeInputType = errors.New("calls: invalid recognize input type")
+	// ErrMissingRecognizeOptions is returned when a request has no recognize options.
+	ErrMissingRecognizeOptions = errors.New("calls: recognize options are required")
+	// ErrMissingChoices is returned when a request recognizes choices without choices.
+	ErrMissingChoices = errors.New("calls: choices are required")
+	// ErrMissingChoiceLabel is returned when a choice has no label.
+	ErrMissingChoiceLabel = errors.New("calls: choice label is required")
+	// ErrDuplicateChoiceLabel is returned when choices have the same label.
+	ErrDuplicateChoiceLabel = errors.New("calls: duplicate choice label")
+	// ErrMissingDtmfOptions is returned when a request recognizes DTMF without DTMF options.
+	ErrMissingDtmfOptions = errors.New("calls: DTMF options are required")
+	// ErrInvalidMaxTonesToCollect is returned when the max tones to collect are out of range.
+	ErrInvalidMaxTonesToCollect = errors.New("calls: invalid max tones to collect")
+	// ErrInvalidTimeout is returned when a timeout is out of range.
+	ErrInvalidTimeout = errors.New("calls: invalid timeout")
+	// ErrAmbiguousPlayPrompt is returned when a request has both a play prompt and play prompts.
+	ErrAmbiguousPlayPrompt = errors.New("calls: only one of play prompt and play prompts is allowed")
+)
+
+// CallRecognizeRequest is the body for recognizing call.
+type CallRecognizeRequest struct {
+	// RecognizeInputType is the type of input to recognize.
+	RecognizeInputType RecognizeInputType `json:"recognizeInputType"`
+	// RecognizeOptions is the options for recognizing.
+	RecognizeOptions *RecognizeOptions `json:"recognizeOptions,omitempty"`
+	// InterruptCallMediaOperation interrupts the playing and recognizing of the call.
+	InterruptCallMediaOperation bool `json:"interruptCallMediaOperation,omitempty"`
+	// OperationCallbackUri overrides the callback uri of the call for the events of the operation.
+	OperationCallbackUri string `json:"operationCallbackUri,omitempty"`
+	// OperationContext is the operation context. It is generated if it is empty.
+	OperationContext string `json:"operationContext,omitempty"`
+	// PlayPrompt is the prompt that is played before recognizing.
+	PlayPrompt *PlaySource `json:"playPrompt,omitempty"`
+	// PlayPrompts are the prompts that are played before recognizing.
+	PlayPrompts []PlaySource `json:"playPrompts,omitempty"`
+}
+
+// RecognizeInputType is the type of input for recognizing call.
+type RecognizeInputType string
+
+const (
+	// RecognizeInputTypeChoices is the type of input for recognizing call.
+	RecognizeInputTypeChoices RecognizeInputType = "choices"
+	// RecognizeInputTypeDtmf is the type of input for recognizing call.
+	RecognizeInputTypeDtmf RecognizeInputType = "dtmf"
+	// RecognizeInputTypeSpeech is the type of input for recognizing call.
+	RecognizeInputTypeSpeech RecognizeInputType = "speech"
+	// RecognizeInputTypeSpeechOrDtmf is the type of input for recognizing call.
+	RecognizeInputTypeSpeechOrDtmf RecognizeInputType = "speechOrDtmf"
+)
+
+// RecognizeOptions is the options for recognizing call.
+type RecognizeOptions struct {
+	// Choices is the list of choices for recognizing call.
+	Choices []Choice `json:"choices,omitempty"`
+	// DtmfOptions is the options for recognizing DTMF.
+	DtmfOptions *DtmfOptions `json:"dtmfOptions,omitempty"`
+	// InitialSilenceTimeoutInSeconds is the initial silence timeout in seconds.
+	InitialSilenceTimeoutInSeconds int `json:"initialSilenceTimeoutInSeconds,omitempty"`
+	// InterruptPrompt is the prompt for interrupting.
+	InterruptPrompt bool `json:"interruptPrompt,omitempty"`
+	// SpeechLanguage is the language for speech.
+	SpeechLanguage string `json:"speechLanguage,omitempty"`
+	// SpeechOptions is the options for recognizing speech.
+	SpeechOptions *SpeechOptions `json:"speechOptions,omitempty"`
+	// SpeechRecognitionModelEndpointId is the endpoint ID for speech recognition model.
+	SpeechRecognitionModelEndpointId string `json:"speechRecognitionModelEndpointId,omitempty"`
+	// TargetParticipant is the target participant for recognizing.
+	TargetParticipant *CommunicationIdentifier `json:"targetParticipant"`
+}
+
+// Choice is the choice for recognizing call.
+type Choice struct {
+	// Label is the label for choice.
+	Label string `json:"label"`
+	// Phrase is the phrase for choice.
+	Phrases []string `json:"phrases"`
+	// Tone is the tone for choice. A choice without a tone is only recognized by its phrases.
+	Tone Tone `json:"tone,omitempty"`
+}
+
+// Tone is the tone for choice.
+type Tone string
+
+const (
+	// ToneA is the a tone for choice.
+	ToneA Tone = "a"
+	// ToneB is the b tone for choice.
+	ToneB Tone = "b"
+	// ToneC is the c tone for choice.
+	ToneC Tone = "c"
+	// ToneD is the d tone for choice.
+	ToneD Tone = "d"
+	// ToneEight is the eight tone for choice.
+	ToneEight Tone = "eight"
+	// ToneFive is the five tone for choice.
+	ToneFive Tone = "five"
+	// ToneFour is the four tone for choice.
+	ToneFour Tone = "four"
+	// ToneNine is the nine tone for choice.
+	ToneNine Tone = "nine"
+	// ToneOne is the one tone for choice.
+	ToneOne Tone = "one"
+	// ToneSeven is the seven tone for choice.
+	ToneSeven Tone = "seven"
+	// ToneSix is the six tone for choice.
+	ToneSix Tone = "six"
+	// ToneStar is the star tone for choice.
+	ToneStar Tone = "star"
+	// ToneThree is the three tone for choice.
+	ToneThree Tone = "three"
+	// ToneTwo is the two tone for choice.
+	ToneTwo Tone = "two"
+	// ToneZero is the zero tone for choice.
+	ToneZero Tone = "zero"
+	// TonePound is the pound tone for choice.
+	TonePound Tone = "pound"
+)
+
+// digits maps the tones to the characters on a keypad.
+var digits = map[Tone]string{
+	ToneZero: "0", ToneOne: "1", ToneTwo: "2", ToneThree: "3", ToneFour: "4",
+	ToneFive: "5", ToneSix: "6", ToneSeven: "7", ToneEight: "8", ToneNine: "9",
+	ToneA: "A", ToneB: "B", ToneC: "C", ToneD: "D", ToneStar: "*", TonePound: "#",
+}
+
+// Digit returns the character of the tone on a keypad, like "1" or "#".
+// It returns an empty string for unknown tones.
+func (t Tone) Digit() string {
+	return digits[t]
+}
+
+// Tones is a sequence of tones.
+type Tones []Tone
+
+// String returns the tones as digit string, like "1234#".
+// Unknown tones are skipped.
+func (t Tones) String() string {
+	var b strings.Builder
+
+	for _, tone := range t {
+		b.WriteString(tone.Digit())
+	}
+
+	return b.String()
+}
+
+// DtmfOptions is the options for recognizing DTMF.
+type DtmfOptions struct {
+	// InterDigitTimeoutInSeconds is the time to wait between tones in seconds.
+	InterDigitTimeoutInSeconds int `json:"interToneTimeoutInSeconds,omitempty"`
+	// MaxTonesToCollect is the max tones to collect.
+	MaxTonesToCollect int `json:"maxTonesToCollect"`
+	// StopTones is the stop tones.
+	StopTones []Tone `json:"stopTones,omitempty"`
+}
+
+// SpeechOptions is the options for recognizing speech.
+type SpeechOptions struct {
+	// EndSilenceTimeoutInMs is the end silence timeout in milliseconds.
+	EndSilenceTimeoutInMs int `json:"endSilenceTimeoutInMs,omitempty"`
+}
+
+// Validate validates the request.
+func (r *CallRecognizeRequest) Validate() error {
+	var errs []error
+
+	if r.PlayPrompt != nil && len(r.PlayPrompts) > 0 {
+		errs = append(errs, ErrAmbiguousPlayPrompt)
+	}
+
+	if r.PlayPrompt != nil {
+		errs = append(errs, r.PlayPrompt.Validate())
+	}
+
+	for _, prompt := range r.PlayPrompts {
+		errs = append(errs, prompt.Validate())
+	}
+
+	if r.RecognizeOptions == nil {
+		return errors.Join(append(errs, ErrMissingRecognizeOptions)...)
+	}
+
+	o := r.RecognizeOptions
+
+	if o.TargetParticipant == nil {
+		errs = append(errs, ErrMissingParticipant)
+	}
+
+	if o.InitialSilenceTimeoutInSeconds < 0 || o.InitialSilenceTimeoutInSeconds > MaxInitialSilenceTimeoutInSeconds {
+		errs = append(errs, fmt.Errorf("%w: initial silence timeout must be between 0 and %d seconds", ErrInvalidTimeout, MaxInitialSilenceTimeoutInSeconds))
+	}
+
+	if o.SpeechOptions != nil && o.SpeechOptions.EndSilenceTimeoutInMs < 0 {
+		errs = append(errs, fmt.Errorf("%w: end silence timeout must not be negative", ErrInvalidTimeout))
+	}
+
+	choices := r.RecognizeInputType == RecognizeInputTypeChoices
+	dtmf := r.RecognizeInputType == RecognizeInputTypeDtmf || r.RecognizeInputType == RecognizeInputTypeSpeechOrDtmf
+
+	switch r.RecognizeInputType {
+	case RecognizeInputTypeChoices, RecognizeInputTypeDtmf, RecognizeInputTypeSpeech, RecognizeInputTypeSpeechOrDtmf:
+	case "":
+		errs = append(errs, ErrMissingRecognizeInputType)
+	default:
+		errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidRecognizeInputType, r.RecognizeInputType))
+	}
+
+	if choices {
+		errs = append(errs, validateChoices(o.Choices))
+	} else if len(o.Choices) > 0 {
+		errs = append(errs, fmt.Errorf("%w: choices require input type %q", ErrInvalidRecognizeInputType, RecognizeInputTypeChoices))
+	}
+
+	switch {
+	case dtmf && o.DtmfOptions == nil:
+		errs = append(errs, ErrMissingDtmfOptions)
+	case dtmf:
+		errs = append(errs, o.DtmfOptions.Validate())
+	case o.DtmfOptions != nil:
+		errs = append(errs, fmt.Errorf("%w: DTMF options require input type %q or %q", ErrInvalidRecognizeInputType, RecognizeInputTypeDtmf, RecognizeInputTypeSpeechOrDtmf))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateChoices validates that there are choices with unique labels and valid tones.
+func validateChoices(choices []Choice) error {
+	if len(choices) == 0 {
+		return ErrMissingChoices
+	}
+
+	var errs []error
+
+	labels := make(map[string]bool, len(choices))
+
+	for _, choice := range choices {
+		switch {
+		case choice.Label == "":
+			errs = append(errs, ErrMissingChoiceLabel)
+		case labels[choice.Label]:
+			errs = append(errs, fmt.Errorf("%w: %q", ErrDuplicateChoiceLabel, choice.Label))
+		}
+		labels[choice.Label] = true
+
+		if choice.Tone != "" && choice.Tone.Digit() == "" {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidTone, choice.Tone))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate validates the options.
+func (o *DtmfOptions) Validate() error {
+	var errs []error
+
+	if o.MaxTonesToCollect < MinTonesToCollect || o.MaxTonesToCollect > MaxTonesToCollect {
+		errs = append(errs, fmt.Errorf("%w: must be between %d and %d", ErrInvalidMaxTonesToCollect, MinTonesToCollect, MaxTonesToCollect))
+	}
+
+	if o.InterDigitTimeoutInSeconds < 0 || o.InterDigitTimeoutInSeconds > MaxInterDigitTimeoutInSeconds {
+		errs = append(errs, fmt.Errorf("%w: inter-digit timeout must be between 0 and %d seconds", ErrInvalidTimeout, MaxInterDigitTimeoutInSeconds))
+	}
+
+	for _, tone := range o.StopTones {
+		if tone.Digit() == "" {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidTone, tone))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CallMediaRecognize is used to recognize the call.
+// The operation context is generated if it is empty and is echoed in the
+// RecognizeCompleted, RecognizeFailed and RecognizeCanceled events of the operation.
+func (s *Service) CallMediaRecognize(ctx context.Context, id string, body *CallRecognizeRequest) error {
+	if err := body.Validate(); err != nil {
+		return err
+	}
+
+	operationContext(&body.OperationContext)
+
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/callConnections/%s:recognize", id)).QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package calls_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestService_CallMediaRecognize(t *testing.T) {
+	bodies := make(chan map[string]any, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/calling/callConnections/call-1:recognize", r.URL.Path)
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		body := map[string]any{}
+		require.NoError(t, json.Unmarshal(b, &body))
+		bodies <- body
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	req := &calls.CallRecognizeRequest{
+		RecognizeInputType: calls.RecognizeInputTypeDtmf,
+		RecognizeOptions: &calls.RecognizeOptions{
+			DtmfOptions:       &calls.DtmfOptions{MaxTonesToCollect: 4, StopTones: []calls.Tone{calls.TonePound}},
+			TargetParticipant: phoneNumber("+14255550123"),
+		},
+	}
+	require.NoError(t, client.Call.CallMediaRecognize(t.Context(), "call-1", req))
+
+	body := <-bodies
+	require.Equal(t, req.OperationContext, body["operationContext"])
+	require.NotContains(t, body, "operatonContext")
+	require.NotContains(t, body, "playPrompt")
+	require.NotContains(t, body, "playPrompts")
+
+	options := body["recognizeOptions"].(map[string]any)
+	require.NotContains(t, options, "initialSilenceTimeoutInSeconds")
+	require.NotContains(t, options, "speechRecognitionModelEndpointId")
+	require.EqualValues(t, 4, options["dtmfOptions"].(map[string]any)["maxTonesToCollect"])
+}
+
+func TestChoice_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(calls.Choice{Label: "Acknowledged", Phrases: []string{"Acknowledge", "Ack"}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"label":"Acknowledged","phrases":["Acknowledge","Ack"]}`, string(b))
+
+	b, err = json.Marshal(calls.Choice{Label: "Declined", Phrases: []string{"Decline"}, Tone: calls.ToneZero})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"label":"Declined","phrases":["Decline"],"tone":"zero"}`, string(b))
+}
+
+func TestCallRecognizeRequest_Validate(t *testing.T) {
+	target := phoneNumber("+14255550123")
+	prompt := calls.PlaySource{Kind: calls.PlaySourceTypeText, TextSource: &calls.TextSource{Text: "Press 1 or 0."}}
+
+	tests := []struct {
+		name string
+		req  calls.CallRecognizeRequest
+		errs []error
+	}{
+		{
+			name: "choices",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeChoices,
+				PlayPrompt:         &prompt,
+				RecognizeOptions: &calls.RecognizeOptions{
+					Choices:           []calls.Choice{{Label: "Yes", Tone: calls.ToneOne}, {Label: "No", Tone: calls.ToneZero}},
+					TargetParticipant: target,
+				},
+			},
+		},
+		{
+			name: "speech or dtmf",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeSpeechOrDtmf,
+				PlayPrompts:        []calls.PlaySource{prompt},
+				RecognizeOptions: &calls.RecognizeOptions{
+					DtmfOptions:                    &calls.DtmfOptions{MaxTonesToCollect: 60, InterDigitTimeoutInSeconds: 5},
+					InitialSilenceTimeoutInSeconds: 300,
+					TargetParticipant:              target,
+				},
+			},
+		},
+		{
+			name: "missing options",
+			req:  calls.CallRecognizeRequest{RecognizeInputType: calls.RecognizeInputTypeSpeech},
+			errs: []error{calls.ErrMissingRecognizeOptions},
+		},
+		{
+			name: "missing input type and participant",
+			req:  calls.CallRecognizeRequest{RecognizeOptions: &calls.RecognizeOptions{}},
+			errs: []error{calls.ErrMissingRecognizeInputType, calls.ErrMissingParticipant},
+		},
+		{
+			name: "choice labels",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeChoices,
+				RecognizeOptions: &calls.RecognizeOptions{
+					Choices:           []calls.Choice{{Label: "Yes"}, {Label: "Yes"}, {Tone: "eleven"}},
+					TargetParticipant: target,
+				},
+			},
+			errs: []error{calls.ErrDuplicateChoiceLabel, calls.ErrMissingChoiceLabel, calls.ErrInvalidTone},
+		},
+		{
+			name: "options of another input type",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeSpeech,
+				RecognizeOptions: &calls.RecognizeOptions{
+					Choices:           []calls.Choice{{Label: "Yes"}},
+					DtmfOptions:       &calls.DtmfOptions{MaxTonesToCollect: 1},
+					TargetParticipant: target,
+				},
+			},
+			errs: []error{calls.ErrInvalidRecognizeInputType},
+		},
+		{
+			name: "dtmf ranges",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeDtmf,
+				PlayPrompt:         &calls.PlaySource{Kind: calls.PlaySourceTypeText},
+				PlayPrompts:        []calls.PlaySource{prompt},
+				RecognizeOptions: &calls.RecognizeOptions{
+					DtmfOptions:                    &calls.DtmfOptions{MaxTonesToCollect: 61, InterDigitTimeoutInSeconds: 61},
+					InitialSilenceTimeoutInSeconds: 301,
+					TargetParticipant:              target,
+				},
+			},
+			errs: []error{calls.ErrInvalidMaxTonesToCollect, calls.ErrInvalidTimeout, calls.ErrAmbiguousPlayPrompt, calls.ErrInvalidPlaySource},
+		},
+		{
+			name: "missing dtmf options",
+			req: calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeDtmf,
+				RecognizeOptions:   &calls.RecognizeOptions{TargetParticipant: target},
+			},
+			errs: []error{calls.ErrMissingDtmfOptions},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			for _, e := range tt.errs {
+				require.ErrorIs(t, err, e)
+			}
+		})
+	}
+}
+
+func TestCreateCallRequest_Validate(t *testing.T) {
+	err := (&calls.CreateCallRequest{}).Validate()
+	require.ErrorIs(t, err, calls.ErrMissingCallbackURI)
+	require.ErrorIs(t, err, calls.ErrMissingTarget)
+
+	err = (&calls.CreateCallRequest{
+		CallbackUri: "https://example.com/callback",
+		Targets:     []calls.CommunicationIdentifier{*phoneNumber("+14255550123")},
+	}).Validate()
+	require.NoError(t, err)
+}
@@ -0,0 +1,363 @@
+package calls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+// DefaultMaxResumes is the default number of times an interrupted download is resumed.
+const DefaultMaxResumes = 3
+
+var (
+	// ErrMissingCallLocator is returned when a recording request has neither a call locator nor a call connection id.
+	ErrMissingCallLocator = errors.New("calls: call locator or call connection id is required")
+	// ErrAmbiguousCallLocator is returned when a recording request has both a call locator and a call connection id.
+	ErrAmbiguousCallLocator = errors.New("calls: only one of call locator and call connection id is allowed")
+	// ErrMissingLocation is returned when a recording location is empty.
+	ErrMissingLocation = errors.New("calls: recording location is required")
+)
+
+// CallLocatorKind is the kind of a call locator.
+type CallLocatorKind string
+
+const (
+	// CallLocatorKindServerCallLocator locates a call by its server call id.
+	CallLocatorKindServerCallLocator CallLocatorKind = "serverCallLocator"
+	// CallLocatorKindGroupCallLocator locates a call by its group call id.
+	CallLocatorKindGroupCallLocator CallLocatorKind = "groupCallLocator"
+	// CallLocatorKindRoomCallLocator locates a call by its room id.
+	CallLocatorKindRoomCallLocator CallLocatorKind = "roomCallLocator"
+)
+
+// CallLocator locates a call.
+type CallLocator struct {
+	// Kind is the kind of the locator.
+	Kind CallLocatorKind `json:"kind"`
+	// ServerCallID is the server call id.
+	ServerCallID string `json:"serverCallId,omitempty"`
+	// GroupCallID is the group call id.
+	GroupCallID string `json:"groupCallId,omitempty"`
+	// RoomID is the room id.
+	RoomID string `json:"roomId,omitempty"`
+}
+
+// ServerCallLocator returns the locator of a call by its server call id.
+func ServerCallLocator(serverCallID string) *CallLocator {
+	return &CallLocator{Kind: CallLocatorKindServerCallLocator, ServerCallID: serverCallID}
+}
+
+// RecordingContentType is the content of a recording.
+type RecordingContentType string
+
+const (
+	// RecordingContentTypeAudio records audio.
+	RecordingContentTypeAudio RecordingContentType = "audio"
+	// RecordingContentTypeAudioVideo records audio and video.
+	RecordingContentTypeAudioVideo RecordingContentType = "audioVideo"
+)
+
+// RecordingChannelType is the channel of a recording.
+type RecordingChannelType string
+
+const (
+	// RecordingChannelTypeMixed records all participants into one channel.
+	RecordingChannelTypeMixed RecordingChannelType = "mixed"
+	// RecordingChannelTypeUnmixed records each participant into its own channel.
+	RecordingChannelTypeUnmixed RecordingChannelType = "unmixed"
+)
+
+// RecordingFormatType is the format of a recording.
+type RecordingFormatType string
+
+const (
+	// RecordingFormatTypeWav is the wav format.
+	RecordingFormatTypeWav RecordingFormatType = "wav"
+	// RecordingFormatTypeMp3 is the mp3 format.
+	RecordingFormatTypeMp3 RecordingFormatType = "mp3"
+	// RecordingFormatTypeMp4 is the mp4 format.
+	RecordingFormatTypeMp4 RecordingFormatType = "mp4"
+)
+
+// RecordingStorageKind is the kind of the storage of a recording.
+type RecordingStorageKind string
+
+const (
+	// RecordingStorageKindAzureCommunicationServices stores the recording in Azure Communication Services.
+	RecordingStorageKindAzureCommunicationServices RecordingStorageKind = "azureCommunicationServices"
+	// RecordingStorageKindAzureBlobStorage stores the recording in an Azure Blob Storage container.
+	RecordingStorageKindAzureBlobStorage RecordingStorageKind = "azureBlobStorage"
+)
+
+// RecordingStorage is the storage of a recording.
+type RecordingStorage struct {
+	// RecordingStorageKind is the kind of the storage.
+	RecordingStorageKind RecordingStorageKind `json:"recordingStorageKind"`
+	// RecordingDestinationContainerUrl is the URL of the blob container for Azure Blob Storage.
+	RecordingDestinationContainerUrl string `json:"recordingDestinationContainerUrl,omitempty"`
+}
+
+// ChannelAffinity assigns a participant to a channel of an unmixed recording.
+type ChannelAffinity struct {
+	// Channel is the channel of the participant.
+	Channel int `json:"channel"`
+	// Participant is the participant.
+	Participant CommunicationIdentifier `json:"participant"`
+}
+
+// StartRecordingRequest is the body for starting a recording.
+type StartRecordingRequest struct {
+	// CallLocator locates the call to record.
+	CallLocator *CallLocator `json:"callLocator,omitempty"`
+	// CallConnectionID is the call connection to record, instead of the call locator.
+	CallConnectionID string `json:"callConnectionId,omitempty"`
+	// RecordingStateCallbackUri is the callback uri for the recording state events.
+	RecordingStateCallbackUri string `json:"recordingStateCallbackUri,omitempty"`
+	// RecordingContentType is the content of the recording.
+	RecordingContentType RecordingContentType `json:"recordingContentType,omitempty"`
+	// RecordingChannelType is the channel of the recording.
+	RecordingChannelType RecordingChannelType `json:"recordingChannelType,omitempty"`
+	// RecordingFormatType is the format of the recording.
+	RecordingFormatType RecordingFormatType `json:"recordingFormatType,omitempty"`
+	// AudioChannelParticipantOrdering is the order of the participants in the channels of an unmixed recording.
+	AudioChannelParticipantOrdering []CommunicationIdentifier `json:"audioChannelParticipantOrdering,omitempty"`
+	// ChannelAffinity assigns participants to channels of an unmixed recording.
+	ChannelAffinity []ChannelAffinity `json:"channelAffinity,omitempty"`
+	// PauseOnStart starts the recording paused.
+	PauseOnStart bool `json:"pauseOnStart,omitempty"`
+	// ExternalStorage is the storage of the recording.
+	ExternalStorage *RecordingStorage `json:"externalStorage,omitempty"`
+}
+
+// Validate validates the request.
+func (r *StartRecordingRequest) Validate() error {
+	switch {
+	case r.CallLocator == nil && r.CallConnectionID == "":
+		return ErrMissingCallLocator
+	case r.CallLocator != nil && r.CallConnectionID != "":
+		return ErrAmbiguousCallLocator
+	}
+
+	return nil
+}
+
+// RecordingState is the state of a recording.
+type RecordingState string
+
+const (
+	// RecordingStateActive is the state of a running recording.
+	RecordingStateActive RecordingState = "active"
+	// RecordingStateInactive is the state of a paused recording.
+	RecordingStateInactive RecordingState = "inactive"
+)
+
+// RecordingStateResponse is the state of a recording.
+type RecordingStateResponse struct {
+	// RecordingID is the ID of the recording.
+	RecordingID string `json:"recordingId"`
+	// RecordingState is the state of the recording.
+	RecordingState RecordingState `json:"recordingState"`
+	// RecordingKind is the kind of the recording.
+	RecordingKind string `json:"recordingKind,omitempty"`
+}
+
+// StartRecording starts the recording of a call.
+func (s *Service) StartRecording(ctx context.Context, body *StartRecordingRequest) (*RecordingStateResponse, error) {
+	if err := body.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := &RecordingStateResponse{}
+
+	req := repeatability.Set(ctx, s.client.New().Post("/calling/recordings").QueryStruct(s.version).BodyJSON(body))
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetRecordingState returns the state of a recording.
+func (s *Service) GetRecordingState(ctx context.Context, recordingID string) (*RecordingStateResponse, error) {
+	res := &RecordingStateResponse{}
+
+	req := s.client.New().Get(fmt.Sprintf("/calling/recordings/%s", url.PathEscape(recordingID))).QueryStruct(s.version)
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PauseRecording pauses a recording.
+func (s *Service) PauseRecording(ctx context.Context, recordingID string) error {
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/recordings/%s:pause", url.PathEscape(recordingID))).QueryStruct(s.version))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResumeRecording resumes a paused recording.
+func (s *Service) ResumeRecording(ctx context.Context, recordingID string) error {
+	req := repeatability.Set(ctx, s.client.New().Post(fmt.Sprintf("/calling/recordings/%s:resume", url.PathEscape(recordingID))).QueryStruct(s.version))
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StopRecording stops a recording.
+// The files of the recording are announced with the RecordingFileStatusUpdated event.
+func (s *Service) StopRecording(ctx context.Context, recordingID string) error {
+	req := s.client.New().Delete(fmt.Sprintf("/calling/recordings/%s", url.PathEscape(recordingID))).QueryStruct(s.version)
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DownloadOpt is the option for downloading a recording.
+type DownloadOpt func(*download)
+
+type download struct {
+	offset     int64
+	maxResumes int
+}
+
+// WithOffset starts the download at the offset, like the size of a partially downloaded file.
+func WithOffset(offset int64) DownloadOpt {
+	return func(d *download) {
+		d.offset = offset
+	}
+}
+
+// WithMaxResumes sets the number of times an interrupted download is resumed.
+func WithMaxResumes(n int) DownloadOpt {
+	return func(d *download) {
+		d.maxResumes = n
+	}
+}
+
+// DownloadRecording streams a recording file from its content location into w.
+// An interrupted download is resumed with a range request where it stopped.
+// It returns the number of bytes written to w.
+func (s *Service) DownloadRecording(ctx context.Context, contentLocation string, w io.Writer, opts ...DownloadOpt) (int64, error) {
+	if contentLocation == "" {
+		return 0, ErrMissingLocation
+	}
+
+	d := &download{maxResumes: DefaultMaxResumes}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	var written int64
+
+	for resumes := 0; ; resumes++ {
+		n, err := s.downloadRange(ctx, contentLocation, w, d.offset+written)
+		written += n
+
+		var readErr *readError
+		if err == nil || !errors.As(err, &readErr) || ctx.Err() != nil || resumes >= d.maxResumes {
+			return written, err
+		}
+	}
+}
+
+// readError is an error reading the body of a download, after which the download can be resumed.
+type readError struct {
+	err error
+}
+
+func (e *readError) Error() string {
+	return e.err.Error()
+}
+
+func (e *readError) Unwrap() error {
+	return e.err
+}
+
+// bodyReader wraps the errors reading the body of a download.
+type bodyReader struct {
+	r io.Reader
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if err != nil && err != io.EOF {
+		err = &readError{err: err}
+	}
+
+	return n, err
+}
+
+// downloadRange downloads the content location from the offset into w.
+func (s *Service) downloadRange(ctx context.Context, contentLocation string, w io.Writer, offset int64) (int64, error) {
+	req := s.client.New().Get(contentLocation)
+	if offset > 0 {
+		req = req.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	res, err := httpx.Send(ctx, s.doer, req)
+	if err != nil {
+		var status interface{ HTTPStatusCode() int }
+		if errors.As(err, &status) && status.HTTPStatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			// The file was already downloaded completely.
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		return 0, fmt.Errorf("calls: downloading recording: %s", res.Status)
+	}
+
+	var body io.Reader = &bodyReader{r: res.Body}
+
+	if res.StatusCode == http.StatusOK && offset > 0 {
+		// The server ignored the range, so the downloaded part is skipped.
+		if _, err := io.CopyN(io.Discard, body, offset); err != nil {
+			return 0, err
+		}
+	}
+
+	return io.Copy(w, body)
+}
+
+// DeleteRecording deletes a recording file by its delete location.
+func (s *Service) DeleteRecording(ctx context.Context, deleteLocation string) error {
+	if deleteLocation == "" {
+		return ErrMissingLocation
+	}
+
+	req := s.client.New().Delete(deleteLocation)
+
+	_, err := httpx.Receive(ctx, s.doer, req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package calls_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/calls"
+)
+
+func TestService_Recording(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "POST /calling/recordings":
+			body := calls.StartRecordingRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, calls.CallLocatorKindServerCallLocator, body.CallLocator.Kind)
+			require.Equal(t, calls.RecordingFormatTypeMp3, body.RecordingFormatType)
+			require.Equal(t, calls.RecordingStorageKindAzureBlobStorage, body.ExternalStorage.RecordingStorageKind)
+
+			_ = json.NewEncoder(w).Encode(calls.RecordingStateResponse{RecordingID: "recording/1", RecordingState: calls.RecordingStateActive})
+		case "GET /calling/recordings/recording%2F1":
+			_ = json.NewEncoder(w).Encode(calls.RecordingStateResponse{RecordingID: "recording/1", RecordingState: calls.RecordingStateInactive})
+		case "POST /calling/recordings/recording%2F1:pause", "POST /calling/recordings/recording%2F1:resume":
+			w.WriteHeader(http.StatusAccepted)
+		case "DELETE /calling/recordings/recording%2F1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Call.StartRecording(t.Context(), &calls.StartRecordingRequest{
+		CallLocator:          calls.ServerCallLocator("server-call-1"),
+		RecordingContentType: calls.RecordingContentTypeAudio,
+		RecordingChannelType: calls.RecordingChannelTypeUnmixed,
+		RecordingFormatType:  calls.RecordingFormatTypeMp3,
+		ChannelAffinity: []calls.ChannelAffinity{
+			{Channel: 0, Participant: *phoneNumber("+14255550123")},
+		},
+		ExternalStorage: &calls.RecordingStorage{
+			RecordingStorageKind:             calls.RecordingStorageKindAzureBlobStorage,
+			RecordingDestinationContainerUrl: "https://example.blob.core.windows.net/recordings",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "recording/1", res.RecordingID)
+
+	require.NoError(t, client.Call.PauseRecording(t.Context(), res.RecordingID))
+
+	state, err := client.Call.GetRecordingState(t.Context(), res.RecordingID)
+	require.NoError(t, err)
+	require.Equal(t, calls.RecordingStateInactive, state.RecordingState)
+
+	require.NoError(t, client.Call.ResumeRecording(t.Context(), res.RecordingID))
+	require.NoError(t, client.Call.StopRecording(t.Context(), res.RecordingID))
+
+	_, err = client.Call.StartRecording(t.Context(), &calls.StartRecordingRequest{})
+	require.ErrorIs(t, err, calls.ErrMissingCallLocator)
+
+	_, err = client.Call.StartRecording(t.Context(), &calls.StartRecordingRequest{CallLocator: calls.ServerCallLocator("server-call-1"), CallConnectionID: "call-1"})
+	require.ErrorIs(t, err, calls.ErrAmbiguousCallLocator)
+}
+
+func TestService_DownloadRecording(t *testing.T) {
+	content := []byte(strings.Repeat("recording", 1000))
+
+	tests := []struct {
+		name        string
+		ignoreRange bool
+	}{
+		{name: "range"},
+		{name: "ignored range", ignoreRange: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := &atomic.Int32{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/v1/objects/document-1/content/audio", r.URL.Path)
+				require.NotEmpty(t, r.Header.Get("Authorization"))
+
+				switch requests.Add(1) {
+				case 1:
+					require.Empty(t, r.Header.Get("Range"))
+
+					// Interrupt the download in the middle of the body.
+					w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+					_, _ = w.Write(content[:len(content)/2])
+					w.(http.Flusher).Flush()
+					panic(http.ErrAbortHandler)
+				default:
+					require.Equal(t, fmt.Sprintf("bytes=%d-", len(content)/2), r.Header.Get("Range"))
+
+					if tt.ignoreRange {
+						_, _ = w.Write(content)
+						return
+					}
+
+					w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", len(content)/2, len(content)-1, len(content)))
+					w.WriteHeader(http.StatusPartialContent)
+					_, _ = w.Write(content[len(content)/2:])
+				}
+			}))
+			defer srv.Close()
+
+			client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+			buf := &bytes.Buffer{}
+			n, err := client.Call.DownloadRecording(t.Context(), srv.URL+"/v1/objects/document-1/content/audio", buf)
+			require.NoError(t, err)
+			require.Equal(t, int64(len(content)), n)
+			require.Equal(t, content, buf.Bytes())
+			require.Equal(t, int32(2), requests.Load())
+		})
+	}
+}
+
+func TestService_DownloadRecording_MaxResumes(t *testing.T) {
+	requests := &atomic.Int32{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		if requests.Add(1) > 1 {
+			status = http.StatusPartialContent
+		}
+
+		w.Header().Set("Content-Length", "100")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	buf := &bytes.Buffer{}
+	n, err := client.Call.DownloadRecording(t.Context(), srv.URL+"/content", buf, calls.WithMaxResumes(1))
+	require.Error(t, err)
+	require.Equal(t, int64(len("partial")*2), n)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestService_DeleteRecording(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, "/v1/objects/document-1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	require.NoError(t, client.Call.DeleteRecording(t.Context(), srv.URL+"/v1/objects/document-1"))
+	require.ErrorIs(t, client.Call.DeleteRecording(t.Context(), ""), calls.ErrMissingLocation)
+}
@@ -0,0 +1,113 @@
+package acs
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrMalformedConnectionString is returned when the connection string can not be parsed.
+	ErrMalformedConnectionString = errors.New("acs: malformed connection string")
+	// ErrMissingEndpoint is returned when the connection string has no endpoint.
+	ErrMissingEndpoint = errors.New("acs: connection string is missing the endpoint")
+	// ErrMissingAccessKey is returned when the connection string has no access key.
+	ErrMissingAccessKey = errors.New("acs: connection string is missing the access key")
+	// ErrInvalidEndpoint is returned when the endpoint is not an absolute http(s) URL.
+	ErrInvalidEndpoint = errors.New("acs: connection string has an invalid endpoint")
+	// ErrInvalidAccessKey is returned when the access key is not base64 encoded.
+	ErrInvalidAccessKey = errors.New("acs: connection string has an invalid access key")
+)
+
+// ConnectionStringError is the error for a connection string that can not be used.
+type ConnectionStringError struct {
+	// Part is the part of the connection string that failed, like "endpoint" or "segment 2".
+	// It never contains the value of a segment, which could be the access key.
+	Part string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *ConnectionStringError) Error() string {
+	if e.Part == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s (%s)", e.Err.Error(), e.Part)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConnectionStringError) Unwrap() error {
+	return e.Err
+}
+
+// ConnectionString is a parsed ACS connection string.
+type ConnectionString struct {
+	// Endpoint is the endpoint of the resource.
+	Endpoint string
+	// AccessKey is the access key of the resource.
+	AccessKey string
+}
+
+// ParseConnectionString parses a connection string like
+// "endpoint=https://x.communication.azure.com/;accesskey=...".
+func ParseConnectionString(s string) (*ConnectionString, error) {
+	cs := &ConnectionString{}
+
+	for i, part := range strings.Split(strings.TrimSpace(s), ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		segment := fmt.Sprintf("segment %d", i+1)
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, &ConnectionStringError{Part: segment, Err: ErrMalformedConnectionString}
+		}
+
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "endpoint":
+			cs.Endpoint = strings.TrimSpace(value)
+		case "accesskey":
+			cs.AccessKey = strings.TrimSpace(value)
+		default:
+			// The key is not reported, it could be a fragment of the access key.
+			return nil, &ConnectionStringError{Part: segment, Err: ErrMalformedConnectionString}
+		}
+	}
+
+	if cs.Endpoint == "" {
+		return nil, &ConnectionStringError{Part: "endpoint", Err: ErrMissingEndpoint}
+	}
+
+	if cs.AccessKey == "" {
+		return nil, &ConnectionStringError{Part: "accesskey", Err: ErrMissingAccessKey}
+	}
+
+	u, err := url.Parse(cs.Endpoint)
+	if err != nil || !u.IsAbs() || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, &ConnectionStringError{Part: "endpoint", Err: ErrInvalidEndpoint}
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(cs.AccessKey); err != nil {
+		return nil, &ConnectionStringError{Part: "accesskey", Err: ErrInvalidAccessKey}
+	}
+
+	return cs, nil
+}
+
+// NewFromConnectionString creates a new Client from a connection string.
+func NewFromConnectionString(connectionString string, c *http.Client, opts ...Opt) (*Client, error) {
+	cs, err := ParseConnectionString(connectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(cs.Endpoint, cs.AccessKey, c, opts...), nil
+}
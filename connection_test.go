@@ -0,0 +1,112 @@
+package acs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+)
+
+func TestParseConnectionString(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		endpoint string
+		key      string
+		err      error
+		part     string
+	}{
+		{
+			name:     "valid",
+			in:       "endpoint=https://x.communication.azure.com/;accesskey=c2VjcmV0a2V5PT0=",
+			endpoint: "https://x.communication.azure.com/",
+			key:      "c2VjcmV0a2V5PT0=",
+		},
+		{
+			name:     "mixed case and trailing separator",
+			in:       " Endpoint=https://x.communication.azure.com/; AccessKey=c2VjcmV0; ",
+			endpoint: "https://x.communication.azure.com/",
+			key:      "c2VjcmV0",
+		},
+		{
+			name: "missing endpoint",
+			in:   "accesskey=c2VjcmV0",
+			err:  acs.ErrMissingEndpoint,
+			part: "endpoint",
+		},
+		{
+			name: "missing access key",
+			in:   "endpoint=https://x.communication.azure.com/",
+			err:  acs.ErrMissingAccessKey,
+			part: "accesskey",
+		},
+		{
+			name: "malformed part",
+			in:   "endpoint=https://x.communication.azure.com/;accesskey",
+			err:  acs.ErrMalformedConnectionString,
+			part: "segment 2",
+		},
+		{
+			name: "unknown part",
+			in:   "endpoint=https://x.communication.azure.com/;accesskey=c2VjcmV0;foo=bar",
+			err:  acs.ErrMalformedConnectionString,
+			part: "segment 3",
+		},
+		{
+			name: "access key split by separator",
+			in:   "endpoint=https://x.communication.azure.com/;accesskey=c2Vj;cmV0a2V5",
+			err:  acs.ErrMalformedConnectionString,
+			part: "segment 3",
+		},
+		{
+			name: "access key with padding split by separator",
+			in:   "endpoint=https://x.communication.azure.com/;accesskey=c2Vj;cmV0a2V5PT0=",
+			err:  acs.ErrMalformedConnectionString,
+			part: "segment 3",
+		},
+		{
+			name: "relative endpoint",
+			in:   "endpoint=x.communication.azure.com;accesskey=c2VjcmV0",
+			err:  acs.ErrInvalidEndpoint,
+			part: "endpoint",
+		},
+		{
+			name: "invalid access key",
+			in:   "endpoint=https://x.communication.azure.com/;accesskey=not base64!",
+			err:  acs.ErrInvalidAccessKey,
+			part: "accesskey",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, err := acs.ParseConnectionString(tt.in)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				var csErr *acs.ConnectionStringError
+				require.ErrorAs(t, err, &csErr)
+				require.Equal(t, tt.part, csErr.Part)
+				require.NotContains(t, err.Error(), "c2Vj")
+				require.NotContains(t, err.Error(), "cmV0")
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.endpoint, cs.Endpoint)
+			require.Equal(t, tt.key, cs.AccessKey)
+		})
+	}
+}
+
+func TestNewFromConnectionString(t *testing.T) {
+	c, err := acs.NewFromConnectionString("endpoint=https://x.communication.azure.com/;accesskey=c2VjcmV0", nil)
+	require.NoError(t, err)
+	require.NotNil(t, c.SMS)
+	require.NotNil(t, c.Call)
+	require.NotNil(t, c.Identity)
+
+	_, err = acs.NewFromConnectionString("", nil)
+	require.ErrorIs(t, err, acs.ErrMissingEndpoint)
+}
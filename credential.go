@@ -0,0 +1,125 @@
+package acs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultScope is the scope for bearer tokens of Azure Communication Services.
+const DefaultScope = "https://communication.azure.com/.default"
+
+// DefaultRefreshWindow is the time before expiry at which a cached token is refreshed.
+const DefaultRefreshWindow = 5 * time.Minute
+
+// ErrEmptyToken is returned when a credential returns an empty token.
+var ErrEmptyToken = errors.New("acs: credential returned an empty token")
+
+// AccessToken is a bearer token with its expiry.
+type AccessToken struct {
+	// Token is the bearer token.
+	Token string
+	// ExpiresOn is the time the token expires.
+	ExpiresOn time.Time
+}
+
+// TokenCredential provides bearer tokens for Microsoft Entra ID authentication.
+// Managed identity, workload identity or any other token source can implement it.
+type TokenCredential interface {
+	// GetToken returns a token for the given scopes.
+	GetToken(ctx context.Context, scopes ...string) (AccessToken, error)
+}
+
+// TokenCredentialFunc is an adapter to use a function as TokenCredential.
+type TokenCredentialFunc func(ctx context.Context, scopes ...string) (AccessToken, error)
+
+// GetToken returns a token for the given scopes.
+func (f TokenCredentialFunc) GetToken(ctx context.Context, scopes ...string) (AccessToken, error) {
+	return f(ctx, scopes...)
+}
+
+// BearerTokenSigner signs requests with a bearer token.
+// Tokens are cached and refreshed before they expire.
+type BearerTokenSigner struct {
+	credential    TokenCredential
+	scopes        []string
+	refreshWindow time.Duration
+
+	mu    sync.Mutex
+	token AccessToken
+}
+
+// BearerTokenOpt is the option for the bearer token signer.
+type BearerTokenOpt func(*BearerTokenSigner)
+
+// WithScopes sets the scopes requested from the credential.
+func WithScopes(scopes ...string) BearerTokenOpt {
+	return func(s *BearerTokenSigner) {
+		s.scopes = scopes
+	}
+}
+
+// WithRefreshWindow sets the time before expiry at which a token is refreshed.
+func WithRefreshWindow(d time.Duration) BearerTokenOpt {
+	return func(s *BearerTokenSigner) {
+		s.refreshWindow = d
+	}
+}
+
+// NewBearerTokenSigner returns a new BearerTokenSigner.
+func NewBearerTokenSigner(credential TokenCredential, opts ...BearerTokenOpt) *BearerTokenSigner {
+	s := &BearerTokenSigner{
+		credential:    credential,
+		scopes:        []string{DefaultScope},
+		refreshWindow: DefaultRefreshWindow,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Sign signs the request with a bearer token.
+func (s *BearerTokenSigner) Sign(req *http.Request) error {
+	token, err := s.Token(req.Context())
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	return nil
+}
+
+// Token returns the cached token or requests a new one when it is about to expire.
+func (s *BearerTokenSigner) Token(ctx context.Context) (AccessToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token.Token != "" && now.Add(s.refreshWindow).Before(s.token.ExpiresOn) {
+		return s.token, nil
+	}
+
+	token, err := s.credential.GetToken(ctx, s.scopes...)
+	if err == nil && token.Token == "" {
+		err = ErrEmptyToken
+	}
+
+	if err != nil {
+		// Keep using the cached token as long as it is still valid.
+		if s.token.Token != "" && now.Before(s.token.ExpiresOn) {
+			return s.token, nil
+		}
+
+		return AccessToken{}, err
+	}
+
+	s.token = token
+
+	return token, nil
+}
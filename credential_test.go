@@ -0,0 +1,116 @@
+package acs_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/identities"
+)
+
+// newTokenIssuer returns a fake token issuer that hands out numbered tokens.
+func newTokenIssuer(t *testing.T, expiresIn time.Duration) (*httptest.Server, *int32) {
+	var issued int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, acs.DefaultScope, r.URL.Query().Get("scope"))
+
+		n := atomic.AddInt32(&issued, 1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"expires_in":   int(expiresIn.Seconds()),
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &issued
+}
+
+func issuerCredential(url string) acs.TokenCredential {
+	return acs.TokenCredentialFunc(func(ctx context.Context, scopes ...string) (acs.AccessToken, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"?scope="+scopes[0], nil)
+		if err != nil {
+			return acs.AccessToken{}, err
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return acs.AccessToken{}, err
+		}
+		defer res.Body.Close()
+
+		body := struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int    `json:"expires_in"`
+		}{}
+		if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+			return acs.AccessToken{}, err
+		}
+
+		return acs.AccessToken{
+			Token:     body.AccessToken,
+			ExpiresOn: time.Now().Add(time.Duration(body.ExpiresIn) * time.Second),
+		}, nil
+	})
+}
+
+func TestBearerTokenSigner_Caching(t *testing.T) {
+	issuer, issued := newTokenIssuer(t, time.Hour)
+	signer := acs.NewBearerTokenSigner(issuerCredential(issuer.URL))
+
+	for range 3 {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, signer.Sign(req))
+		require.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+	}
+
+	require.EqualValues(t, 1, atomic.LoadInt32(issued))
+}
+
+func TestBearerTokenSigner_Refresh(t *testing.T) {
+	issuer, issued := newTokenIssuer(t, time.Minute)
+	signer := acs.NewBearerTokenSigner(issuerCredential(issuer.URL), acs.WithRefreshWindow(2*time.Minute))
+
+	for i := 1; i <= 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, signer.Sign(req))
+		require.Equal(t, fmt.Sprintf("Bearer token-%d", i), req.Header.Get("Authorization"))
+	}
+
+	require.EqualValues(t, 2, atomic.LoadInt32(issued))
+}
+
+func TestBearerTokenSigner_EmptyToken(t *testing.T) {
+	signer := acs.NewBearerTokenSigner(acs.TokenCredentialFunc(func(ctx context.Context, scopes ...string) (acs.AccessToken, error) {
+		return acs.AccessToken{}, nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.ErrorIs(t, signer.Sign(req), acs.ErrEmptyToken)
+}
+
+func TestNewWithTokenCredential(t *testing.T) {
+	issuer, _ := newTokenIssuer(t, time.Hour)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		require.Empty(t, r.Header.Get("x-ms-content-sha256"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"identity":{"id":"8:acs:test"}}`))
+	}))
+	defer srv.Close()
+
+	client := acs.NewWithTokenCredential(srv.URL, issuerCredential(issuer.URL), srv.Client())
+
+	res, err := client.Identity.CreateIdentity(context.Background(), &identities.CreateIdentityRequestBody{})
+	require.NoError(t, err)
+	require.Equal(t, "8:acs:test", res.Identity.ID)
+}
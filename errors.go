@@ -0,0 +1,144 @@
+package acs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// HeaderRequestID is the header for the request id of a response.
+	HeaderRequestID = "x-ms-request-id"
+	// HeaderCorrelationVector is the header for the correlation vector of a response.
+	HeaderCorrelationVector = "MS-CV"
+)
+
+// maxErrorBodySize is the maximum size of an error body that is read.
+const maxErrorBodySize = 1 << 20
+
+// CommunicationError is the error returned by the ACS API.
+type CommunicationError struct {
+	// Code is the error code.
+	Code string `json:"code"`
+	// Message is the error message.
+	Message string `json:"message"`
+	// Target is the target of the error.
+	Target string `json:"target,omitempty"`
+	// Details are further details about specific errors that led to this error.
+	Details []CommunicationError `json:"details,omitempty"`
+	// InnerError is the inner error if any.
+	InnerError *CommunicationError `json:"innererror,omitempty"`
+}
+
+// ResponseError is the error for a failed response of the ACS API.
+type ResponseError struct {
+	CommunicationError
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// RequestID is the x-ms-request-id header of the response.
+	RequestID string
+	// CorrelationVector is the MS-CV header of the response.
+	CorrelationVector string
+	// Header is the header of the response.
+	Header http.Header
+	// Body is the raw body of the response.
+	Body []byte
+}
+
+// Error returns the error message.
+func (e *ResponseError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "acs: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+
+	if e.Code != "" {
+		fmt.Fprintf(&b, ": %s", e.Code)
+	}
+
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request id %s)", e.RequestID)
+	}
+
+	return b.String()
+}
+
+// HTTPStatusCode returns the HTTP status code of the response.
+func (e *ResponseError) HTTPStatusCode() int {
+	return e.StatusCode
+}
+
+// newResponseError creates a new ResponseError from a failed response.
+// The body of the response is consumed and closed.
+func newResponseError(res *http.Response) *ResponseError {
+	defer res.Body.Close()
+
+	e := &ResponseError{
+		StatusCode:        res.StatusCode,
+		RequestID:         res.Header.Get(HeaderRequestID),
+		CorrelationVector: res.Header.Get(HeaderCorrelationVector),
+		Header:            res.Header,
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	if err != nil {
+		return e
+	}
+	e.Body = body
+
+	v := struct {
+		Error CommunicationError `json:"error"`
+	}{}
+
+	if err := json.Unmarshal(body, &v); err == nil {
+		e.CommunicationError = v.Error
+	}
+
+	return e
+}
+
+// IsNotFound returns true if the error is a response error with status 404.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsThrottled returns true if the error is a response error with status 429.
+func IsThrottled(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsConflict returns true if the error is a response error with status 409.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized returns true if the error is a response error with status 401.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// HasErrorCode returns true if the error is a response error with the given error code.
+func HasErrorCode(err error, code string) bool {
+	var e *ResponseError
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return strings.EqualFold(e.Code, code)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var e *ResponseError
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return e.StatusCode == code
+}
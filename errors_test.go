@@ -0,0 +1,59 @@
+package acs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+)
+
+func TestResponseError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-ms-request-id", "request-1")
+		w.Header().Set("MS-CV", "cv-1")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{
+			"error": {
+				"code": "8522",
+				"message": "Call not found.",
+				"target": "callConnectionId",
+				"details": [{"code": "8523", "message": "Detail."}],
+				"innererror": {"code": "8524", "message": "Inner."}
+			}
+		}`))
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	_, err := client.Call.CreateCall(context.Background(), newCreateCallRequest())
+	require.Error(t, err)
+
+	var resErr *acs.ResponseError
+	require.True(t, errors.As(err, &resErr))
+	require.Equal(t, http.StatusNotFound, resErr.StatusCode)
+	require.Equal(t, "8522", resErr.Code)
+	require.Equal(t, "Call not found.", resErr.Message)
+	require.Equal(t, "callConnectionId", resErr.Target)
+	require.Len(t, resErr.Details, 1)
+	require.Equal(t, "8523", resErr.Details[0].Code)
+	require.Equal(t, "8524", resErr.InnerError.Code)
+	require.Equal(t, "request-1", resErr.RequestID)
+	require.Equal(t, "cv-1", resErr.CorrelationVector)
+
+	require.True(t, acs.IsNotFound(err))
+	require.False(t, acs.IsThrottled(err))
+	require.False(t, acs.IsConflict(err))
+	require.True(t, acs.HasErrorCode(err, "8522"))
+}
+
+func TestIsThrottled(t *testing.T) {
+	err := &acs.ResponseError{StatusCode: http.StatusTooManyRequests}
+	require.True(t, acs.IsThrottled(err))
+	require.False(t, acs.IsNotFound(errors.New("test")))
+}
@@ -0,0 +1,400 @@
+package events
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultIssuer is the issuer of the tokens of call automation callbacks.
+	DefaultIssuer = "https://acscallautomation.communication.azure.com"
+	// DefaultJWKSURL is the URL of the signing keys of call automation callbacks.
+	DefaultJWKSURL = "https://acscallautomation.communication.azure.com/calling/keys"
+	// DefaultLeeway is the default clock skew that is tolerated when validating the token lifetime.
+	DefaultLeeway = time.Minute
+	// DefaultKeysTTL is the default time the signing keys are cached.
+	DefaultKeysTTL = 24 * time.Hour
+	// DefaultMinRefreshInterval is the default minimum time between two fetches of the signing keys.
+	DefaultMinRefreshInterval = time.Minute
+)
+
+var (
+	// ErrMissingToken is returned when the request carries no bearer token.
+	ErrMissingToken = errors.New("events: missing bearer token")
+	// ErrInvalidToken is returned when the token is malformed.
+	ErrInvalidToken = errors.New("events: invalid token")
+	// ErrUnsupportedAlgorithm is returned when the token is not signed with RS256.
+	ErrUnsupportedAlgorithm = errors.New("events: unsupported signing algorithm")
+	// ErrUnknownKey is returned when the token is signed with an unknown key.
+	ErrUnknownKey = errors.New("events: unknown signing key")
+	// ErrInvalidSignature is returned when the signature of the token is invalid.
+	ErrInvalidSignature = errors.New("events: invalid token signature")
+	// ErrInvalidIssuer is returned when the token is issued by another issuer.
+	ErrInvalidIssuer = errors.New("events: invalid token issuer")
+	// ErrInvalidAudience is returned when the token is issued for another audience.
+	ErrInvalidAudience = errors.New("events: invalid token audience")
+	// ErrTokenExpired is returned when the token is expired or not yet valid.
+	ErrTokenExpired = errors.New("events: token is expired or not yet valid")
+	// ErrInvalidJWKS is returned when the static signing keys can not be parsed.
+	ErrInvalidJWKS = errors.New("events: invalid signing keys")
+)
+
+// Authenticator authenticates the requests delivering events.
+type Authenticator interface {
+	// Authenticate returns an error if the request is not authenticated.
+	Authenticate(r *http.Request) error
+}
+
+// AuthenticatorFunc is a function that implements the Authenticator interface.
+type AuthenticatorFunc func(r *http.Request) error
+
+// Authenticate returns an error if the request is not authenticated.
+func (f AuthenticatorFunc) Authenticate(r *http.Request) error {
+	return f(r)
+}
+
+// WithAuthenticator sets the authenticator for the requests delivering events.
+// Validation handshakes are not authenticated.
+func WithAuthenticator(a Authenticator) Opt {
+	return func(h *EventHandler) {
+		h.authenticator = a
+	}
+}
+
+// JWTAuthenticator verifies the signed JWT of call automation callbacks.
+type JWTAuthenticator struct {
+	audience           string
+	issuer             string
+	jwksURL            string
+	client             *http.Client
+	leeway             time.Duration
+	keysTTL            time.Duration
+	minRefreshInterval time.Duration
+	now                func() time.Time
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+	err     error
+}
+
+// JWTOpt is the option for the JWT authenticator.
+type JWTOpt func(*JWTAuthenticator)
+
+// WithIssuer sets the expected issuer of the tokens.
+func WithIssuer(issuer string) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.issuer = issuer
+	}
+}
+
+// WithJWKSURL sets the URL of the signing keys.
+func WithJWKSURL(url string) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.jwksURL = url
+	}
+}
+
+// WithJWKS sets a static set of signing keys, like the contents of a JWKS file.
+// Without a JWKS URL the keys are never refreshed.
+// If the keys can not be parsed, every token is rejected with ErrInvalidJWKS.
+func WithJWKS(jwks []byte) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.jwksURL = ""
+
+		keys, err := parseJWKS(jwks)
+		if err != nil {
+			a.err = fmt.Errorf("%w: %w", ErrInvalidJWKS, err)
+			return
+		}
+
+		a.keys = keys
+		a.fetched = a.now()
+	}
+}
+
+// WithHTTPClient sets the HTTP client for fetching the signing keys.
+func WithHTTPClient(c *http.Client) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.client = c
+	}
+}
+
+// WithLeeway sets the clock skew that is tolerated when validating the token lifetime.
+func WithLeeway(d time.Duration) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.leeway = d
+	}
+}
+
+// WithKeysTTL sets the time the signing keys are cached.
+func WithKeysTTL(d time.Duration) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.keysTTL = d
+	}
+}
+
+// WithMinRefreshInterval sets the minimum time between two fetches of the signing keys.
+// It limits the fetches caused by tokens with unknown key ids.
+func WithMinRefreshInterval(d time.Duration) JWTOpt {
+	return func(a *JWTAuthenticator) {
+		a.minRefreshInterval = d
+	}
+}
+
+// NewJWTAuthenticator returns a new authenticator for call automation callbacks.
+// The audience is the immutable resource ID of the Communication Services resource.
+func NewJWTAuthenticator(audience string, opts ...JWTOpt) *JWTAuthenticator {
+	a := &JWTAuthenticator{
+		audience:           audience,
+		issuer:             DefaultIssuer,
+		jwksURL:            DefaultJWKSURL,
+		client:             http.DefaultClient,
+		leeway:             DefaultLeeway,
+		keysTTL:            DefaultKeysTTL,
+		minRefreshInterval: DefaultMinRefreshInterval,
+		now:                time.Now,
+		keys:               map[string]*rsa.PublicKey{},
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+}
+
+// audience is the aud claim, which is either a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+
+	var ss []string
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return err
+	}
+	*a = ss
+
+	return nil
+}
+
+// Authenticate verifies the bearer token of the request.
+func (a *JWTAuthenticator) Authenticate(r *http.Request) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return ErrMissingToken
+	}
+
+	return a.Verify(r.Context(), token)
+}
+
+// Verify verifies the signature, issuer, audience and lifetime of the token.
+func (a *JWTAuthenticator) Verify(ctx context.Context, token string) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+
+	header := jwtHeader{}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return err
+	}
+
+	if header.Algorithm != "RS256" {
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, header.Algorithm)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	key, err := a.key(ctx, header.KeyID)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return ErrInvalidSignature
+	}
+
+	claims := jwtClaims{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return err
+	}
+
+	if claims.Issuer != a.issuer {
+		return fmt.Errorf("%w: %q", ErrInvalidIssuer, claims.Issuer)
+	}
+
+	if !slices.Contains(claims.Audience, a.audience) {
+		return ErrInvalidAudience
+	}
+
+	now := a.now()
+
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(a.leeway)) {
+		return ErrTokenExpired
+	}
+
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0).Add(-a.leeway)) {
+		return ErrTokenExpired
+	}
+
+	return nil
+}
+
+// key returns the signing key with the key id. The keys are fetched
+// when they are expired, or when the key id is unknown.
+func (a *JWTAuthenticator) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+
+	key, ok := a.keys[kid]
+	if ok && now.Sub(a.fetched) < a.keysTTL {
+		return key, nil
+	}
+
+	if a.jwksURL == "" || (!a.fetched.IsZero() && now.Sub(a.fetched) < a.minRefreshInterval) {
+		if ok {
+			return key, nil
+		}
+
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+
+	keys, err := a.fetch(ctx)
+	if err != nil {
+		// Keep using a cached key if the keys can not be refreshed.
+		if ok {
+			return key, nil
+		}
+
+		return nil, err
+	}
+
+	a.keys = keys
+	a.fetched = now
+
+	key, ok = a.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+
+	return key, nil
+}
+
+func (a *JWTAuthenticator) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.jwksURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("events: fetching signing keys: %s", res.Status)
+	}
+
+	jwks := json.RawMessage{}
+	if err := json.NewDecoder(res.Body).Decode(&jwks); err != nil {
+		return nil, err
+	}
+
+	return parseJWKS(jwks)
+}
+
+// parseJWKS parses the RSA keys of a JSON Web Key Set.
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	jwks := struct {
+		Keys []struct {
+			KeyType string `json:"kty"`
+			KeyID   string `json:"kid"`
+			Use     string `json:"use"`
+			N       string `json:"n"`
+			E       string `json:"e"`
+		} `json:"keys"`
+	}{}
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+
+	for _, k := range jwks.Keys {
+		if k.KeyType != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[k.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func decodeSegment(segment string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package events_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+)
+
+const audience = "1f4e9b9a-0a2a-4a8f-9e5b-3c2d1e0f9a8b"
+
+type signingKey struct {
+	kid string
+	key *rsa.PrivateKey
+}
+
+func newSigningKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return signingKey{kid: kid, key: key}
+}
+
+func (k signingKey) jwk() map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"use": "sig",
+		"kid": k.kid,
+		"n":   base64.RawURLEncoding.EncodeToString(k.key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.E)).Bytes()),
+	}
+}
+
+func (k signingKey) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": k.kid})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(unsigned))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, k.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func claims(mutate ...func(map[string]any)) map[string]any {
+	c := map[string]any{
+		"iss": events.DefaultIssuer,
+		"aud": audience,
+		"nbf": time.Now().Add(-time.Minute).Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+
+	for _, m := range mutate {
+		m(c)
+	}
+
+	return c
+}
+
+func jwksServer(t *testing.T, keys *atomic.Value, fetches *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+
+		jwks := map[string]any{"keys": []map[string]string{}}
+		for _, k := range keys.Load().([]signingKey) {
+			jwks["keys"] = append(jwks["keys"].([]map[string]string), k.jwk())
+		}
+
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestJWTAuthenticator_Verify(t *testing.T) {
+	key := newSigningKey(t, "key-1")
+	other := newSigningKey(t, "key-1")
+
+	keys := &atomic.Value{}
+	keys.Store([]signingKey{key})
+
+	fetches := &atomic.Int32{}
+	srv := jwksServer(t, keys, fetches)
+
+	a := events.NewJWTAuthenticator(audience, events.WithJWKSURL(srv.URL), events.WithHTTPClient(srv.Client()))
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{name: "valid", token: key.sign(t, claims())},
+		{name: "audience array", token: key.sign(t, claims(func(c map[string]any) { c["aud"] = []string{"other", audience} }))},
+		{name: "expired", token: key.sign(t, claims(func(c map[string]any) { c["exp"] = time.Now().Add(-time.Hour).Unix() })), err: events.ErrTokenExpired},
+		{name: "not yet valid", token: key.sign(t, claims(func(c map[string]any) { c["nbf"] = time.Now().Add(time.Hour).Unix() })), err: events.ErrTokenExpired},
+		{name: "wrong audience", token: key.sign(t, claims(func(c map[string]any) { c["aud"] = "other" })), err: events.ErrInvalidAudience},
+		{name: "wrong issuer", token: key.sign(t, claims(func(c map[string]any) { c["iss"] = "https://example.com" })), err: events.ErrInvalidIssuer},
+		{name: "wrong signature", token: other.sign(t, claims()), err: events.ErrInvalidSignature},
+		{name: "malformed", token: "foo.bar", err: events.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.Verify(t.Context(), tt.token)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	require.Equal(t, int32(1), fetches.Load())
+}
+
+func TestJWTAuthenticator_KeyRotation(t *testing.T) {
+	old := newSigningKey(t, "key-1")
+	rotated := newSigningKey(t, "key-2")
+
+	keys := &atomic.Value{}
+	keys.Store([]signingKey{old})
+
+	fetches := &atomic.Int32{}
+	srv := jwksServer(t, keys, fetches)
+
+	a := events.NewJWTAuthenticator(audience,
+		events.WithJWKSURL(srv.URL),
+		events.WithHTTPClient(srv.Client()),
+		events.WithMinRefreshInterval(0),
+	)
+
+	require.NoError(t, a.Verify(t.Context(), old.sign(t, claims())))
+
+	keys.Store([]signingKey{rotated})
+
+	require.NoError(t, a.Verify(t.Context(), rotated.sign(t, claims())))
+	require.Equal(t, int32(2), fetches.Load())
+
+	require.ErrorIs(t, a.Verify(t.Context(), newSigningKey(t, "key-3").sign(t, claims())), events.ErrUnknownKey)
+}
+
+func TestJWTAuthenticator_JWKS(t *testing.T) {
+	key := newSigningKey(t, "key-1")
+
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{key.jwk()}})
+	require.NoError(t, err)
+
+	a := events.NewJWTAuthenticator(audience, events.WithJWKS(jwks))
+	require.NoError(t, a.Verify(t.Context(), key.sign(t, claims())))
+}
+
+func TestJWTAuthenticator_MalformedJWKS(t *testing.T) {
+	key := newSigningKey(t, "key-1")
+
+	tests := []struct {
+		name string
+		jwks string
+	}{
+		{name: "json", jwks: `{"keys": [`},
+		{name: "modulus", jwks: `{"keys": [{"kty": "RSA", "kid": "key-1", "n": "not base64!", "e": "AQAB"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := events.NewJWTAuthenticator(audience, events.WithJWKS([]byte(tt.jwks)))
+			require.ErrorIs(t, a.Verify(t.Context(), key.sign(t, claims())), events.ErrInvalidJWKS)
+
+			req := httptest.NewRequest(http.MethodPost, "/events", nil)
+			req.Header.Set("Authorization", "Bearer "+key.sign(t, claims()))
+			require.ErrorIs(t, a.Authenticate(req), events.ErrInvalidJWKS)
+		})
+	}
+}
+
+func TestEventHandler_Authenticator(t *testing.T) {
+	key := newSigningKey(t, "key-1")
+
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{key.jwk()}})
+	require.NoError(t, err)
+
+	hh := events.NewEventHandler(events.WithAuthenticator(events.NewJWTAuthenticator(audience, events.WithJWKS(jwks))))
+
+	req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader("["+cloudEventCallConnected+"]"))
+	rr := httptest.NewRecorder()
+	hh.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/events", strings.NewReader("["+cloudEventCallConnected+"]"))
+	req.Header.Set("Authorization", "Bearer "+key.sign(t, claims()))
+	rr = httptest.NewRecorder()
+	hh.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusAccepted, rr.Code)
+}
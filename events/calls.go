@@ -1,8 +1,82 @@
 package events
 
-// MicrosoftCommunicationCallConnected is the data type of the event.
-// This parses the data of the Microsoft.Communication.CallConnected event.
-type MicrosoftCommunicationCallConnected struct {
+import (
+	"time"
+
+	"github.com/zeiss/go-acs/calls"
+)
+
+const (
+	// EventTypeCallConnected is the type of the Microsoft.Communication.CallConnected event.
+	EventTypeCallConnected = "Microsoft.Communication.CallConnected"
+	// EventTypeCallDisconnected is the type of the Microsoft.Communication.CallDisconnected event.
+	EventTypeCallDisconnected = "Microsoft.Communication.CallDisconnected"
+	// EventTypeCallTransferAccepted is the type of the Microsoft.Communication.CallTransferAccepted event.
+	EventTypeCallTransferAccepted = "Microsoft.Communication.CallTransferAccepted"
+	// EventTypeCallTransferFailed is the type of the Microsoft.Communication.CallTransferFailed event.
+	EventTypeCallTransferFailed = "Microsoft.Communication.CallTransferFailed"
+	// EventTypeParticipantsUpdated is the type of the Microsoft.Communication.ParticipantsUpdated event.
+	EventTypeParticipantsUpdated = "Microsoft.Communication.ParticipantsUpdated"
+	// EventTypeAddParticipantSucceeded is the type of the Microsoft.Communication.AddParticipantSucceeded event.
+	EventTypeAddParticipantSucceeded = "Microsoft.Communication.AddParticipantSucceeded"
+	// EventTypeAddParticipantFailed is the type of the Microsoft.Communication.AddParticipantFailed event.
+	EventTypeAddParticipantFailed = "Microsoft.Communication.AddParticipantFailed"
+	// EventTypeRemoveParticipantSucceeded is the type of the Microsoft.Communication.RemoveParticipantSucceeded event.
+	EventTypeRemoveParticipantSucceeded = "Microsoft.Communication.RemoveParticipantSucceeded"
+	// EventTypeRemoveParticipantFailed is the type of the Microsoft.Communication.RemoveParticipantFailed event.
+	EventTypeRemoveParticipantFailed = "Microsoft.Communication.RemoveParticipantFailed"
+	// EventTypeCancelAddParticipantSucceeded is the type of the Microsoft.Communication.CancelAddParticipantSucceeded event.
+	EventTypeCancelAddParticipantSucceeded = "Microsoft.Communication.CancelAddParticipantSucceeded"
+	// EventTypeCancelAddParticipantFailed is the type of the Microsoft.Communication.CancelAddParticipantFailed event.
+	EventTypeCancelAddParticipantFailed = "Microsoft.Communication.CancelAddParticipantFailed"
+	// EventTypePlayCompleted is the type of the Microsoft.Communication.PlayCompleted event.
+	EventTypePlayCompleted = "Microsoft.Communication.PlayCompleted"
+	// EventTypePlayFailed is the type of the Microsoft.Communication.PlayFailed event.
+	EventTypePlayFailed = "Microsoft.Communication.PlayFailed"
+	// EventTypePlayCanceled is the type of the Microsoft.Communication.PlayCanceled event.
+	EventTypePlayCanceled = "Microsoft.Communication.PlayCanceled"
+	// EventTypeRecognizeCompleted is the type of the Microsoft.Communication.RecognizeCompleted event.
+	EventTypeRecognizeCompleted = "Microsoft.Communication.RecognizeCompleted"
+	// EventTypeRecognizeFailed is the type of the Microsoft.Communication.RecognizeFailed event.
+	EventTypeRecognizeFailed = "Microsoft.Communication.RecognizeFailed"
+	// EventTypeRecognizeCanceled is the type of the Microsoft.Communication.RecognizeCanceled event.
+	EventTypeRecognizeCanceled = "Microsoft.Communication.RecognizeCanceled"
+	// EventTypeContinuousDtmfRecognitionToneReceived is the type of the Microsoft.Communication.ContinuousDtmfRecognitionToneReceived event.
+	EventTypeContinuousDtmfRecognitionToneReceived = "Microsoft.Communication.ContinuousDtmfRecognitionToneReceived"
+	// EventTypeContinuousDtmfRecognitionToneFailed is the type of the Microsoft.Communication.ContinuousDtmfRecognitionToneFailed event.
+	EventTypeContinuousDtmfRecognitionToneFailed = "Microsoft.Communication.ContinuousDtmfRecognitionToneFailed"
+	// EventTypeContinuousDtmfRecognitionStopped is the type of the Microsoft.Communication.ContinuousDtmfRecognitionStopped event.
+	EventTypeContinuousDtmfRecognitionStopped = "Microsoft.Communication.ContinuousDtmfRecognitionStopped"
+	// EventTypeSendDtmfTonesCompleted is the type of the Microsoft.Communication.SendDtmfTonesCompleted event.
+	EventTypeSendDtmfTonesCompleted = "Microsoft.Communication.SendDtmfTonesCompleted"
+	// EventTypeSendDtmfTonesFailed is the type of the Microsoft.Communication.SendDtmfTonesFailed event.
+	EventTypeSendDtmfTonesFailed = "Microsoft.Communication.SendDtmfTonesFailed"
+	// EventTypeRecordingStateChanged is the type of the Microsoft.Communication.RecordingStateChanged event.
+	EventTypeRecordingStateChanged = "Microsoft.Communication.RecordingStateChanged"
+	// EventTypeTranscriptionStarted is the type of the Microsoft.Communication.TranscriptionStarted event.
+	EventTypeTranscriptionStarted = "Microsoft.Communication.TranscriptionStarted"
+	// EventTypeTranscriptionStopped is the type of the Microsoft.Communication.TranscriptionStopped event.
+	EventTypeTranscriptionStopped = "Microsoft.Communication.TranscriptionStopped"
+	// EventTypeTranscriptionUpdated is the type of the Microsoft.Communication.TranscriptionUpdated event.
+	EventTypeTranscriptionUpdated = "Microsoft.Communication.TranscriptionUpdated"
+	// EventTypeTranscriptionFailed is the type of the Microsoft.Communication.TranscriptionFailed event.
+	EventTypeTranscriptionFailed = "Microsoft.Communication.TranscriptionFailed"
+	// EventTypeMediaStreamingStarted is the type of the Microsoft.Communication.MediaStreamingStarted event.
+	EventTypeMediaStreamingStarted = "Microsoft.Communication.MediaStreamingStarted"
+	// EventTypeMediaStreamingStopped is the type of the Microsoft.Communication.MediaStreamingStopped event.
+	EventTypeMediaStreamingStopped = "Microsoft.Communication.MediaStreamingStopped"
+	// EventTypeMediaStreamingFailed is the type of the Microsoft.Communication.MediaStreamingFailed event.
+	EventTypeMediaStreamingFailed = "Microsoft.Communication.MediaStreamingFailed"
+	// EventTypeHoldFailed is the type of the Microsoft.Communication.HoldFailed event.
+	EventTypeHoldFailed = "Microsoft.Communication.HoldFailed"
+	// EventTypeAnswerFailed is the type of the Microsoft.Communication.AnswerFailed event.
+	EventTypeAnswerFailed = "Microsoft.Communication.AnswerFailed"
+	// EventTypeIncomingCall is the type of the Microsoft.Communication.IncomingCall event.
+	EventTypeIncomingCall = "Microsoft.Communication.IncomingCall"
+)
+
+// CallEvent is the data all call automation events have in common.
+type CallEvent struct {
 	// Version is the version of the event.
 	Version string `json:"version"`
 	// CallConnectionID is the ID of the call connection.
@@ -11,27 +85,350 @@ type MicrosoftCommunicationCallConnected struct {
 	ServerCallID string `json:"serverCallId"`
 	// CorrelationID is the ID of the correlation.
 	CorrelationID string `json:"correlationId"`
+	// OperationContext is the context of the operation that caused the event.
+	OperationContext string `json:"operationContext,omitempty"`
+	// ResultInformation is the information of the result.
+	ResultInformation *ResultInformation `json:"resultInformation,omitempty"`
 	// PublicEventType is the type of the event.
 	PublicEventType string `json:"publicEventType"`
 }
 
+// GetCallEvent returns the data all call automation events have in common.
+func (e *CallEvent) GetCallEvent() *CallEvent {
+	return e
+}
+
+// CallEventData is implemented by the data of all call automation events.
+type CallEventData interface {
+	// GetCallEvent returns the data all call automation events have in common.
+	GetCallEvent() *CallEvent
+}
+
+// MicrosoftCommunicationCallConnected is the data type of the event.
+// This parses the data of the Microsoft.Communication.CallConnected event.
+type MicrosoftCommunicationCallConnected struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationCallDisconnected is the data type of the event.
+// This parses the data of the Microsoft.Communication.CallDisconnected event.
+type MicrosoftCommunicationCallDisconnected struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationCallTransferAccepted is the data type of the event.
+// This parses the data of the Microsoft.Communication.CallTransferAccepted event.
+type MicrosoftCommunicationCallTransferAccepted struct {
+	CallEvent
+
+	// TransferTarget is the participant the call was transferred to.
+	TransferTarget *CommunicationIdentifier `json:"transferTarget,omitempty"`
+	// Transferee is the participant that was transferred.
+	Transferee *CommunicationIdentifier `json:"transferee,omitempty"`
+}
+
+// MicrosoftCommunicationCallTransferFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.CallTransferFailed event.
+type MicrosoftCommunicationCallTransferFailed struct {
+	CallEvent
+}
+
 // MicrosoftCommunicationParticipantsUpdated is the data type of the event.
-// This parses the data of the Microsoft.Communication.ParticipantsUpdate event.
+// This parses the data of the Microsoft.Communication.ParticipantsUpdated event.
 type MicrosoftCommunicationParticipantsUpdated struct {
+	CallEvent
+
 	// Participants is the list of participants.
 	Participants []Participant `json:"participants"`
 	// SequenceNumber is the sequence number.
 	SequenceNumber int `json:"sequenceNumber"`
-	// Version is the version of the event.
-	Version string `json:"version"`
-	// CallConnectionID is the ID of the call connection.
-	CallConnectionID string `json:"callConnectionId"`
+}
+
+// MicrosoftCommunicationAddParticipantSucceeded is the data type of the event.
+// This parses the data of the Microsoft.Communication.AddParticipantSucceeded event.
+type MicrosoftCommunicationAddParticipantSucceeded struct {
+	CallEvent
+
+	// Participant is the participant that was added.
+	Participant *CommunicationIdentifier `json:"participant,omitempty"`
+}
+
+// MicrosoftCommunicationAddParticipantFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.AddParticipantFailed event.
+type MicrosoftCommunicationAddParticipantFailed struct {
+	CallEvent
+
+	// Participant is the participant that could not be added.
+	Participant *CommunicationIdentifier `json:"participant,omitempty"`
+}
+
+// MicrosoftCommunicationRemoveParticipantSucceeded is the data type of the event.
+// This parses the data of the Microsoft.Communication.RemoveParticipantSucceeded event.
+type MicrosoftCommunicationRemoveParticipantSucceeded struct {
+	CallEvent
+
+	// Participant is the participant that was removed.
+	Participant *CommunicationIdentifier `json:"participant,omitempty"`
+}
+
+// MicrosoftCommunicationRemoveParticipantFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.RemoveParticipantFailed event.
+type MicrosoftCommunicationRemoveParticipantFailed struct {
+	CallEvent
+
+	// Participant is the participant that could not be removed.
+	Participant *CommunicationIdentifier `json:"participant,omitempty"`
+}
+
+// MicrosoftCommunicationCancelAddParticipantSucceeded is the data type of the event.
+// This parses the data of the Microsoft.Communication.CancelAddParticipantSucceeded event.
+type MicrosoftCommunicationCancelAddParticipantSucceeded struct {
+	CallEvent
+
+	// InvitationID is the ID of the canceled invitation.
+	InvitationID string `json:"invitationId"`
+}
+
+// MicrosoftCommunicationCancelAddParticipantFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.CancelAddParticipantFailed event.
+type MicrosoftCommunicationCancelAddParticipantFailed struct {
+	CallEvent
+
+	// InvitationID is the ID of the invitation that could not be canceled.
+	InvitationID string `json:"invitationId"`
+}
+
+// MicrosoftCommunicationPlayCompleted is the data type of the event.
+// This parses the data of the Microsoft.Communication.PlayCompleted event.
+type MicrosoftCommunicationPlayCompleted struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationPlayFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.PlayFailed event.
+type MicrosoftCommunicationPlayFailed struct {
+	CallEvent
+
+	// FailedPlaySourceIndex is the index of the play source that failed.
+	FailedPlaySourceIndex *int `json:"failedPlaySourceIndex,omitempty"`
+}
+
+// MicrosoftCommunicationPlayCanceled is the data type of the event.
+// This parses the data of the Microsoft.Communication.PlayCanceled event.
+type MicrosoftCommunicationPlayCanceled struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationRecognizeCompleted is the data type of the event.
+// This parses the data of the Microsoft.Communication.RecognizeCompleted event.
+type MicrosoftCommunicationRecognizeCompleted struct {
+	CallEvent
+
+	// RecognitionType is the type of recognition.
+	RecognitionType RecognizeInputType `json:"recognitionType"`
+	// ChoiceResult is the result of choice.
+	ChoiceResult *ChoiceResult `json:"choiceResult,omitempty"`
+	// DtmfResult is the result of DTMF.
+	DtmfResult *DtmfResult `json:"dtmfResult,omitempty"`
+	// SpeechResult is the result of speech.
+	SpeechResult *SpeechResult `json:"speechResult,omitempty"`
+}
+
+// MicrosoftCommunicationRecognizeFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.RecognizeFailed event.
+type MicrosoftCommunicationRecognizeFailed struct {
+	CallEvent
+
+	// FailedPlaySourceIndex is the index of the play source that failed.
+	FailedPlaySourceIndex *int `json:"failedPlaySourceIndex,omitempty"`
+}
+
+// MicrosoftCommunicationRecognizeCanceled is the data type of the event.
+// This parses the data of the Microsoft.Communication.RecognizeCanceled event.
+type MicrosoftCommunicationRecognizeCanceled struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationContinuousDtmfRecognitionToneReceived is the data type of the event.
+// This parses the data of the Microsoft.Communication.ContinuousDtmfRecognitionToneReceived event.
+type MicrosoftCommunicationContinuousDtmfRecognitionToneReceived struct {
+	CallEvent
+
+	// SequenceID is the sequence number of the tone in the call.
+	SequenceID int `json:"sequenceId"`
+	// Tone is the received tone.
+	Tone Tone `json:"tone"`
+}
+
+// MicrosoftCommunicationContinuousDtmfRecognitionToneFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.ContinuousDtmfRecognitionToneFailed event.
+type MicrosoftCommunicationContinuousDtmfRecognitionToneFailed struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationContinuousDtmfRecognitionStopped is the data type of the event.
+// This parses the data of the Microsoft.Communication.ContinuousDtmfRecognitionStopped event.
+type MicrosoftCommunicationContinuousDtmfRecognitionStopped struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationSendDtmfTonesCompleted is the data type of the event.
+// This parses the data of the Microsoft.Communication.SendDtmfTonesCompleted event.
+type MicrosoftCommunicationSendDtmfTonesCompleted struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationSendDtmfTonesFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.SendDtmfTonesFailed event.
+type MicrosoftCommunicationSendDtmfTonesFailed struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationRecordingStateChanged is the data type of the event.
+// This parses the data of the Microsoft.Communication.RecordingStateChanged event.
+type MicrosoftCommunicationRecordingStateChanged struct {
+	CallEvent
+
+	// RecordingID is the ID of the recording.
+	RecordingID string `json:"recordingId"`
+	// State is the state of the recording.
+	State RecordingState `json:"state"`
+	// StartDateTime is the time the recording started.
+	StartDateTime *time.Time `json:"startDateTime,omitempty"`
+	// RecordingKind is the kind of the recording.
+	RecordingKind string `json:"recordingKind,omitempty"`
+}
+
+// RecordingState is the state of a recording.
+type RecordingState string
+
+const (
+	// RecordingStateActive is the state of a running recording.
+	RecordingStateActive RecordingState = "active"
+	// RecordingStateInactive is the state of a paused or stopped recording.
+	RecordingStateInactive RecordingState = "inactive"
+)
+
+// MicrosoftCommunicationTranscriptionStarted is the data type of the event.
+// This parses the data of the Microsoft.Communication.TranscriptionStarted event.
+type MicrosoftCommunicationTranscriptionStarted struct {
+	CallEvent
+
+	// TranscriptionUpdate is the status of the transcription.
+	TranscriptionUpdate *TranscriptionUpdate `json:"transcriptionUpdate,omitempty"`
+}
+
+// MicrosoftCommunicationTranscriptionStopped is the data type of the event.
+// This parses the data of the Microsoft.Communication.TranscriptionStopped event.
+type MicrosoftCommunicationTranscriptionStopped struct {
+	CallEvent
+
+	// TranscriptionUpdate is the status of the transcription.
+	TranscriptionUpdate *TranscriptionUpdate `json:"transcriptionUpdate,omitempty"`
+}
+
+// MicrosoftCommunicationTranscriptionUpdated is the data type of the event.
+// This parses the data of the Microsoft.Communication.TranscriptionUpdated event.
+type MicrosoftCommunicationTranscriptionUpdated struct {
+	CallEvent
+
+	// TranscriptionUpdate is the status of the transcription.
+	TranscriptionUpdate *TranscriptionUpdate `json:"transcriptionUpdate,omitempty"`
+}
+
+// MicrosoftCommunicationTranscriptionFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.TranscriptionFailed event.
+type MicrosoftCommunicationTranscriptionFailed struct {
+	CallEvent
+
+	// TranscriptionUpdate is the status of the transcription.
+	TranscriptionUpdate *TranscriptionUpdate `json:"transcriptionUpdate,omitempty"`
+}
+
+// TranscriptionUpdate is the status of a transcription.
+type TranscriptionUpdate struct {
+	// TranscriptionStatus is the status of the transcription.
+	TranscriptionStatus string `json:"transcriptionStatus"`
+	// TranscriptionStatusDetails are the details of the status.
+	TranscriptionStatusDetails string `json:"transcriptionStatusDetails"`
+}
+
+// MicrosoftCommunicationMediaStreamingStarted is the data type of the event.
+// This parses the data of the Microsoft.Communication.MediaStreamingStarted event.
+type MicrosoftCommunicationMediaStreamingStarted struct {
+	CallEvent
+
+	// MediaStreamingUpdate is the status of the media streaming.
+	MediaStreamingUpdate *MediaStreamingUpdate `json:"mediaStreamingUpdate,omitempty"`
+}
+
+// MicrosoftCommunicationMediaStreamingStopped is the data type of the event.
+// This parses the data of the Microsoft.Communication.MediaStreamingStopped event.
+type MicrosoftCommunicationMediaStreamingStopped struct {
+	CallEvent
+
+	// MediaStreamingUpdate is the status of the media streaming.
+	MediaStreamingUpdate *MediaStreamingUpdate `json:"mediaStreamingUpdate,omitempty"`
+}
+
+// MicrosoftCommunicationMediaStreamingFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.MediaStreamingFailed event.
+type MicrosoftCommunicationMediaStreamingFailed struct {
+	CallEvent
+
+	// MediaStreamingUpdate is the status of the media streaming.
+	MediaStreamingUpdate *MediaStreamingUpdate `json:"mediaStreamingUpdate,omitempty"`
+}
+
+// MediaStreamingUpdate is the status of a media streaming.
+type MediaStreamingUpdate struct {
+	// ContentType is the type of the streamed content.
+	ContentType string `json:"contentType"`
+	// MediaStreamingStatus is the status of the media streaming.
+	MediaStreamingStatus string `json:"mediaStreamingStatus"`
+	// MediaStreamingStatusDetails are the details of the status.
+	MediaStreamingStatusDetails string `json:"mediaStreamingStatusDetails"`
+}
+
+// MicrosoftCommunicationHoldFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.HoldFailed event.
+type MicrosoftCommunicationHoldFailed struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationAnswerFailed is the data type of the event.
+// This parses the data of the Microsoft.Communication.AnswerFailed event.
+type MicrosoftCommunicationAnswerFailed struct {
+	CallEvent
+}
+
+// MicrosoftCommunicationIncomingCall is the data type of the event.
+// This parses the data of the Microsoft.Communication.IncomingCall event.
+type MicrosoftCommunicationIncomingCall struct {
+	// To is the callee.
+	To CommunicationIdentifier `json:"to"`
+	// From is the caller.
+	From CommunicationIdentifier `json:"from"`
 	// ServerCallID is the ID of the server call.
 	ServerCallID string `json:"serverCallId"`
+	// CallerDisplayName is the display name of the caller.
+	CallerDisplayName string `json:"callerDisplayName,omitempty"`
+	// CustomContext are the custom SIP and VoIP headers of the call.
+	CustomContext *CustomContext `json:"customContext,omitempty"`
+	// IncomingCallContext is the context to answer, reject or redirect the call.
+	IncomingCallContext string `json:"incomingCallContext"`
+	// OnBehalfOfCallee is the callee the call is received for, if it was forwarded.
+	OnBehalfOfCallee *CommunicationIdentifier `json:"onBehalfOfCallee,omitempty"`
 	// CorrelationID is the ID of the correlation.
 	CorrelationID string `json:"correlationId"`
-	// PublicEventType is the type of the event.
-	PublicEventType string `json:"publicEventType"`
+}
+
+// CustomContext are the custom headers of a call.
+type CustomContext struct {
+	// SipHeaders are the custom SIP headers.
+	SipHeaders map[string]string `json:"sipHeaders,omitempty"`
+	// VoipHeaders are the custom VoIP headers.
+	VoipHeaders map[string]string `json:"voipHeaders,omitempty"`
 }
 
 // Participant is the participant.
@@ -45,56 +442,35 @@ type Participant struct {
 }
 
 // CommunicationIdentifier is a communication user identifier.
-type CommunicationIdentifier struct {
-	ID                string                      `json:"id,omitempty"`
-	Kind              CommunicationIdentifierKind `json:"kind"`
-	CommunicationUser *CommunicationUser          `json:"communicationUser,omitempty"`
-	PhoneNumber       *PhonenumberIdentifier      `json:"phoneNumber,omitempty"`
-}
+type CommunicationIdentifier = calls.CommunicationIdentifier
 
 // CommunicationIdentifierKind is the kind of the communication identifier.
-type CommunicationIdentifierKind string
+type CommunicationIdentifierKind = calls.CommunicationIdentifierKind
 
 const (
 	// CommunicationIdentifierKindCommunicationUser is the communication user kind.
-	CommunicationIdentifierKindCommunicationUser CommunicationIdentifierKind = "communicationUser"
+	CommunicationIdentifierKindCommunicationUser = calls.CommunicationIdentifierKindCommunicationUser
 	// CommunicationIdentifierKindPhoneNumber is the phone number kind.
-	CommunicationIdentifierKindPhoneNumber CommunicationIdentifierKind = "phoneNumber"
+	CommunicationIdentifierKindPhoneNumber = calls.CommunicationIdentifierKindPhoneNumber
+	// CommunicationIdentifierKindMicrosoftTeamsUser is the Microsoft Teams user kind.
+	CommunicationIdentifierKindMicrosoftTeamsUser = calls.CommunicationIdentifierKindMicrosoftTeamsUser
+	// CommunicationIdentifierKindUnknown is the kind of identifiers that are not known.
+	CommunicationIdentifierKindUnknown = calls.CommunicationIdentifierKindUnknown
 )
 
 // PhonenumberIdentifier is the phone number identifier.
-type PhonenumberIdentifier struct {
-	// ID is the id
-	ID string `json:"id"`
-	// Value is the value.
-	Value string `json:"value"`
-}
+type PhonenumberIdentifier = calls.PhonenumberIdentifier
+
+// CommunicationUserIdentifier is a communication user.
+type CommunicationUserIdentifier = calls.CommunicationUserIdentifier
 
 // CommunicationUser is a communication user.
-type CommunicationUser struct {
-	ID string `json:"id"`
-}
+//
+// Deprecated: Use CommunicationUserIdentifier instead.
+type CommunicationUser = calls.CommunicationUserIdentifier
 
-// MicrosoftCommunicationRecognizeCompleted is the data type of the event.
-// This parses the data of the Microsoft.Communication.RecognizeCompleted event.
-type MicrosoftCommunicationRecognizeCompleted struct {
-	// RecognitionType is the type of recognition.
-	RecognitionType RecognizeInputType `json:"recognitionType"`
-	// ChoiceResult is the result of choice.
-	ChoiceResult *ChoiceResult `json:"choiceResult,omitempty"`
-	// Version is the version of the event.
-	Version string `json:"version"`
-	// ResultInformation is the information of the result.
-	ResultInformation *ResultInformation `json:"resultInformation,omitempty"`
-	// CallConnectionID is the ID of the call connection.
-	CallConnectionID string `json:"callConnectionId"`
-	// ServerCallID is the ID of the server call.
-	ServerCallID string `json:"serverCallId"`
-	// CorrelationID is the ID of the correlation.
-	CorrelationID string `json:"correlationId"`
-	// PublicEventType is the type of the event.
-	PublicEventType string `json:"publicEventType"`
-}
+// MicrosoftTeamsUserIdentifier is a Microsoft Teams user.
+type MicrosoftTeamsUserIdentifier = calls.MicrosoftTeamsUserIdentifier
 
 // RecognizeInputType is the type of input for recognizing call.
 type RecognizeInputType string
@@ -114,8 +490,31 @@ const (
 type ChoiceResult struct {
 	// Label is the label of the choice.
 	Label string `json:"label"`
+	// RecognizedPhrase is the phrase that was recognized for the choice.
+	// It is empty if the choice was recognized by its tone.
+	RecognizedPhrase string `json:"recognizedPhrase,omitempty"`
 }
 
+// DtmfResult is the result for DTMF.
+type DtmfResult struct {
+	// Tones are the recognized tones.
+	Tones Tones `json:"tones"`
+}
+
+// SpeechResult is the result for speech.
+type SpeechResult struct {
+	// Speech is the recognized text.
+	Speech string `json:"speech"`
+	// Confidence is the confidence of the recognition, between 0 and 1.
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// Tone is a DTMF tone.
+type Tone = calls.Tone
+
+// Tones is a sequence of DTMF tones.
+type Tones = calls.Tones
+
 // ResultInformation is the information for result.
 type ResultInformation struct {
 	// Code is the code of the result.
@@ -124,4 +523,16 @@ type ResultInformation struct {
 	SubCode int `json:"subCode"`
 	// Message is the message of the result.
 	Message string `json:"message"`
+	// SipDetails are the SIP details of the result.
+	SipDetails *ResultDetails `json:"sipDetails,omitempty"`
+	// Q850Details are the Q.850 details of the result.
+	Q850Details *ResultDetails `json:"q850Details,omitempty"`
+}
+
+// ResultDetails are the protocol details of a result.
+type ResultDetails struct {
+	// Code is the protocol code.
+	Code int `json:"code"`
+	// Message is the protocol message.
+	Message string `json:"message"`
 }
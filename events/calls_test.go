@@ -0,0 +1,448 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/calls"
+	"github.com/zeiss/go-acs/events"
+)
+
+// capture registers a handler with the router that stores the decoded data.
+func capture[T any, P interface {
+	*T
+	events.CallEventData
+}](on func(*events.Router, func(context.Context, P) error),
+) func(*events.Router, *events.CallEventData) {
+	return func(r *events.Router, got *events.CallEventData) {
+		on(r, func(_ context.Context, data P) error {
+			*got = data
+			return nil
+		})
+	}
+}
+
+func TestCallEvents(t *testing.T) {
+	tests := []struct {
+		name  string
+		on    func(*events.Router, *events.CallEventData)
+		check func(*testing.T, events.CallEventData)
+	}{
+		{
+			name: "CallConnected",
+			on:   capture((*events.Router).OnCallConnected),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCallConnected)
+				require.Empty(t, e.OperationContext)
+				require.Equal(t, 200, e.ResultInformation.Code)
+			},
+		},
+		{
+			name: "CallDisconnected",
+			on:   capture((*events.Router).OnCallDisconnected),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCallDisconnected)
+				require.Equal(t, 7000, e.ResultInformation.SubCode)
+				require.Equal(t, 16, e.ResultInformation.Q850Details.Code)
+				require.Equal(t, "Normal call clearing", e.ResultInformation.Q850Details.Message)
+			},
+		},
+		{
+			name: "CallTransferAccepted",
+			on:   capture((*events.Router).OnCallTransferAccepted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCallTransferAccepted)
+				require.Equal(t, "transferToQueue", e.OperationContext)
+				require.Equal(t, "+14255550123", e.TransferTarget.PhoneNumber.Value)
+				require.Equal(t, events.CommunicationIdentifierKindCommunicationUser, e.Transferee.Kind)
+				require.Equal(t, e.Transferee.RawID, e.Transferee.CommunicationUser.ID)
+			},
+		},
+		{
+			name: "CallTransferFailed",
+			on:   capture((*events.Router).OnCallTransferFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCallTransferFailed)
+				require.Equal(t, "transferToBackup", e.OperationContext)
+				require.Equal(t, 500, e.ResultInformation.Code)
+				require.Equal(t, 7505, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "ParticipantsUpdated",
+			on:   capture((*events.Router).OnParticipantsUpdated),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationParticipantsUpdated)
+				require.Len(t, e.Participants, 2)
+				require.Equal(t, events.CommunicationIdentifierKindCommunicationUser, e.Participants[0].Identifier.Kind)
+				require.Equal(t, "4:+14255550123", e.Participants[1].Identifier.RawID)
+				require.Equal(t, 2, e.SequenceNumber)
+			},
+		},
+		{
+			name: "AddParticipantSucceeded",
+			on:   capture((*events.Router).OnAddParticipantSucceeded),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationAddParticipantSucceeded)
+				require.Equal(t, "addPstnParticipant", e.OperationContext)
+				require.Equal(t, "+14255550123", e.Participant.PhoneNumber.Value)
+			},
+		},
+		{
+			name: "AddParticipantFailed",
+			on:   capture((*events.Router).OnAddParticipantFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationAddParticipantFailed)
+				require.Equal(t, events.CommunicationIdentifierKindPhoneNumber, e.Participant.Kind)
+				require.Equal(t, "+14255550188", e.Participant.PhoneNumber.Value)
+				require.Equal(t, 408, e.ResultInformation.Code)
+				require.Equal(t, 10004, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "RemoveParticipantSucceeded",
+			on:   capture((*events.Router).OnRemoveParticipantSucceeded),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRemoveParticipantSucceeded)
+				require.Equal(t, "removePstnParticipant", e.OperationContext)
+				require.Equal(t, "4:+14255550123", e.Participant.RawID)
+				require.Equal(t, 7016, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "RemoveParticipantFailed",
+			on:   capture((*events.Router).OnRemoveParticipantFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRemoveParticipantFailed)
+				require.Equal(t, "removeAgent", e.OperationContext)
+				require.Equal(t, events.CommunicationIdentifierKindCommunicationUser, e.Participant.Kind)
+				require.Equal(t, "8:acs:1bdaa2b9-9507-4542-bb64-a7b22c00a8d4_00000020-d44a-d73d-ec02-c995dc3b19ae", e.Participant.CommunicationUser.ID)
+				require.Equal(t, 8523, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "CancelAddParticipantSucceeded",
+			on:   capture((*events.Router).OnCancelAddParticipantSucceeded),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCancelAddParticipantSucceeded)
+				require.Equal(t, "cancelAddSupervisor", e.OperationContext)
+				require.Equal(t, "934f7d6d-4629-4cd5-a2d9-37fc4bfbe0f0", e.InvitationID)
+			},
+		},
+		{
+			name: "CancelAddParticipantFailed",
+			on:   capture((*events.Router).OnCancelAddParticipantFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationCancelAddParticipantFailed)
+				require.Equal(t, "9294aa5f-ce5a-41ef-9018-7a82a6a5e2bf", e.InvitationID)
+				require.Equal(t, 400, e.ResultInformation.Code)
+				require.Equal(t, "Invitation not found.", e.ResultInformation.Message)
+			},
+		},
+		{
+			name: "PlayCompleted",
+			on:   capture((*events.Router).OnPlayCompleted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationPlayCompleted)
+				require.Equal(t, "welcomePrompt", e.OperationContext)
+				require.Equal(t, 200, e.ResultInformation.Code)
+			},
+		},
+		{
+			name: "PlayFailed",
+			on:   capture((*events.Router).OnPlayFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationPlayFailed)
+				require.Equal(t, "menuPrompt", e.OperationContext)
+				require.Equal(t, 8535, e.ResultInformation.SubCode)
+				require.Equal(t, 1, *e.FailedPlaySourceIndex)
+			},
+		},
+		{
+			name: "PlayCanceled",
+			on:   capture((*events.Router).OnPlayCanceled),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationPlayCanceled)
+				require.Equal(t, "holdMusic", e.OperationContext)
+				require.Equal(t, 8508, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "RecognizeCompleted",
+			on:   capture((*events.Router).OnRecognizeCompleted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRecognizeCompleted)
+				require.Equal(t, events.RecognizeInputTypeChoices, e.RecognitionType)
+				require.Equal(t, "Acknowledged", e.ChoiceResult.Label)
+				require.Equal(t, "Acknowledge", e.ChoiceResult.RecognizedPhrase)
+				require.Nil(t, e.DtmfResult)
+			},
+		},
+		{
+			name: "RecognizeFailed",
+			on:   capture((*events.Router).OnRecognizeFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRecognizeFailed)
+				require.Equal(t, "collectPin", e.OperationContext)
+				require.Equal(t, 8510, e.ResultInformation.SubCode)
+				require.Equal(t, 0, *e.FailedPlaySourceIndex)
+			},
+		},
+		{
+			name: "RecognizeCanceled",
+			on:   capture((*events.Router).OnRecognizeCanceled),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRecognizeCanceled)
+				require.Equal(t, "collectAccountNumber", e.OperationContext)
+				require.Equal(t, 8508, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "ContinuousDtmfRecognitionToneReceived",
+			on:   capture((*events.Router).OnContinuousDtmfRecognitionToneReceived),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationContinuousDtmfRecognitionToneReceived)
+				require.Equal(t, 3, e.SequenceID)
+				require.Equal(t, calls.ToneFive, e.Tone)
+			},
+		},
+		{
+			name: "ContinuousDtmfRecognitionToneFailed",
+			on:   capture((*events.Router).OnContinuousDtmfRecognitionToneFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationContinuousDtmfRecognitionToneFailed)
+				require.Equal(t, "continuousDtmf", e.OperationContext)
+				require.Equal(t, 400, e.ResultInformation.Code)
+			},
+		},
+		{
+			name: "ContinuousDtmfRecognitionStopped",
+			on:   capture((*events.Router).OnContinuousDtmfRecognitionStopped),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationContinuousDtmfRecognitionStopped)
+				require.Equal(t, "continuousDtmf", e.OperationContext)
+				require.Equal(t, 7000, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "SendDtmfTonesCompleted",
+			on:   capture((*events.Router).OnSendDtmfTonesCompleted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationSendDtmfTonesCompleted)
+				require.Equal(t, "dialExtension", e.OperationContext)
+				require.Equal(t, 200, e.ResultInformation.Code)
+			},
+		},
+		{
+			name: "SendDtmfTonesFailed",
+			on:   capture((*events.Router).OnSendDtmfTonesFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationSendDtmfTonesFailed)
+				require.Equal(t, "dialExtension", e.OperationContext)
+				require.Equal(t, 8508, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "RecordingStateChanged",
+			on:   capture((*events.Router).OnRecordingStateChanged),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationRecordingStateChanged)
+				require.Equal(t, events.RecordingStateActive, e.State)
+				require.Equal(t, "azureCommunicationServices", e.RecordingKind)
+				require.True(t, strings.HasPrefix(e.RecordingID, "eyJQbGF0Zm9ybUVuZHBvaW50SWQi"))
+				require.Equal(t, time.Date(2024, 6, 3, 17, 45, 4, 102938000, time.UTC), e.StartDateTime.UTC())
+			},
+		},
+		{
+			name: "TranscriptionStarted",
+			on:   capture((*events.Router).OnTranscriptionStarted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationTranscriptionStarted)
+				require.Equal(t, "transcriptionStarted", e.TranscriptionUpdate.TranscriptionStatus)
+				require.Equal(t, "subscriptionStarted", e.TranscriptionUpdate.TranscriptionStatusDetails)
+			},
+		},
+		{
+			name: "TranscriptionStopped",
+			on:   capture((*events.Router).OnTranscriptionStopped),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationTranscriptionStopped)
+				require.Equal(t, "transcriptionStopped", e.TranscriptionUpdate.TranscriptionStatus)
+				require.Equal(t, "subscriptionStopped", e.TranscriptionUpdate.TranscriptionStatusDetails)
+			},
+		},
+		{
+			name: "TranscriptionUpdated",
+			on:   capture((*events.Router).OnTranscriptionUpdated),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationTranscriptionUpdated)
+				require.Equal(t, "transcriptionUpdated", e.TranscriptionUpdate.TranscriptionStatus)
+				require.Equal(t, "transcriptionLocaleUpdated", e.TranscriptionUpdate.TranscriptionStatusDetails)
+			},
+		},
+		{
+			name: "TranscriptionFailed",
+			on:   capture((*events.Router).OnTranscriptionFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationTranscriptionFailed)
+				require.Equal(t, "transcriptionFailed", e.TranscriptionUpdate.TranscriptionStatus)
+				require.Equal(t, "speechServicesConnectionError", e.TranscriptionUpdate.TranscriptionStatusDetails)
+				require.Equal(t, 8581, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "MediaStreamingStarted",
+			on:   capture((*events.Router).OnMediaStreamingStarted),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationMediaStreamingStarted)
+				require.Equal(t, "Audio", e.MediaStreamingUpdate.ContentType)
+				require.Equal(t, "mediaStreamingStarted", e.MediaStreamingUpdate.MediaStreamingStatus)
+				require.Equal(t, "subscriptionStarted", e.MediaStreamingUpdate.MediaStreamingStatusDetails)
+			},
+		},
+		{
+			name: "MediaStreamingStopped",
+			on:   capture((*events.Router).OnMediaStreamingStopped),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationMediaStreamingStopped)
+				require.Equal(t, "mediaStreamingStopped", e.MediaStreamingUpdate.MediaStreamingStatus)
+				require.Equal(t, "subscriptionStopped", e.MediaStreamingUpdate.MediaStreamingStatusDetails)
+			},
+		},
+		{
+			name: "MediaStreamingFailed",
+			on:   capture((*events.Router).OnMediaStreamingFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationMediaStreamingFailed)
+				require.Equal(t, "mediaStreamingFailed", e.MediaStreamingUpdate.MediaStreamingStatus)
+				require.Equal(t, "streamConnectionUnsuccessful", e.MediaStreamingUpdate.MediaStreamingStatusDetails)
+				require.Equal(t, 500, e.ResultInformation.Code)
+			},
+		},
+		{
+			name: "HoldFailed",
+			on:   capture((*events.Router).OnHoldFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationHoldFailed)
+				require.Equal(t, "holdCaller", e.OperationContext)
+				require.Equal(t, 8536, e.ResultInformation.SubCode)
+			},
+		},
+		{
+			name: "AnswerFailed",
+			on:   capture((*events.Router).OnAnswerFailed),
+			check: func(t *testing.T, d events.CallEventData) {
+				e := d.(*events.MicrosoftCommunicationAnswerFailed)
+				require.Equal(t, "answerSupportLine", e.OperationContext)
+				require.Equal(t, 8522, e.ResultInformation.SubCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join("testdata", tt.name+".json"))
+			require.NoError(t, err)
+
+			ee, err := events.ParseEvents(events.ContentTypeCloudEventsJSON, b)
+			require.NoError(t, err)
+			require.Len(t, ee, 1)
+			require.Equal(t, "Microsoft.Communication."+tt.name, ee[0].Type())
+
+			var got events.CallEventData
+
+			r := events.NewRouter()
+			tt.on(r, &got)
+			require.NoError(t, r.Dispatch(t.Context(), ee[0]))
+			require.NotNil(t, got)
+
+			e := got.GetCallEvent()
+			require.Equal(t, "calling/callConnections/"+e.CallConnectionID, ee[0].Subject())
+			require.NotEmpty(t, e.ServerCallID)
+			require.NotEmpty(t, e.CorrelationID)
+			require.Equal(t, "Microsoft.Communication."+tt.name, e.PublicEventType)
+			require.NotNil(t, e.ResultInformation)
+
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestMicrosoftCommunicationRecognizeCompleted(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		check func(*testing.T, *events.MicrosoftCommunicationRecognizeCompleted)
+	}{
+		{
+			name: "dtmf",
+			data: `{"recognitionType": "dtmf", "dtmfResult": {"tones": ["one", "two", "three", "four", "pound"]}}`,
+			check: func(t *testing.T, e *events.MicrosoftCommunicationRecognizeCompleted) {
+				require.Equal(t, events.Tones{calls.ToneOne, calls.ToneTwo, calls.ToneThree, calls.ToneFour, calls.TonePound}, e.DtmfResult.Tones)
+				require.Equal(t, "1234#", e.DtmfResult.Tones.String())
+			},
+		},
+		{
+			name: "speech",
+			data: `{"recognitionType": "speech", "speechResult": {"speech": "Acknowledge the incident", "confidence": 0.87}}`,
+			check: func(t *testing.T, e *events.MicrosoftCommunicationRecognizeCompleted) {
+				require.Equal(t, "Acknowledge the incident", e.SpeechResult.Speech)
+				require.InDelta(t, 0.87, e.SpeechResult.Confidence, 0.0001)
+			},
+		},
+		{
+			name: "choices",
+			data: `{"recognitionType": "choices", "choiceResult": {"label": "Acknowledged", "recognizedPhrase": "Ack"}}`,
+			check: func(t *testing.T, e *events.MicrosoftCommunicationRecognizeCompleted) {
+				require.Equal(t, "Acknowledged", e.ChoiceResult.Label)
+				require.Equal(t, "Ack", e.ChoiceResult.RecognizedPhrase)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &events.MicrosoftCommunicationRecognizeCompleted{}
+			require.NoError(t, json.Unmarshal([]byte(tt.data), e))
+			tt.check(t, e)
+		})
+	}
+}
+
+func TestTones_String(t *testing.T) {
+	tones := events.Tones{calls.ToneStar, calls.ToneZero, calls.ToneNine, calls.ToneA, calls.ToneD, "unknown", calls.TonePound}
+	require.Equal(t, "*09AD#", tones.String())
+	require.Empty(t, events.Tones{}.String())
+}
+
+func TestMicrosoftCommunicationIncomingCall(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "IncomingCall.json"))
+	require.NoError(t, err)
+
+	ee, err := events.ParseEvents("application/json", b)
+	require.NoError(t, err)
+	require.Len(t, ee, 1)
+
+	var got *events.MicrosoftCommunicationIncomingCall
+
+	r := events.NewRouter()
+	r.OnIncomingCall(func(_ context.Context, data *events.MicrosoftCommunicationIncomingCall) error {
+		got = data
+		return nil
+	})
+	require.NoError(t, r.Dispatch(t.Context(), ee[0]))
+
+	require.NotNil(t, got)
+	require.Equal(t, "+14255550123", got.To.PhoneNumber.Value)
+	require.Equal(t, "4:+14255550199", got.From.RawID)
+	require.Equal(t, "Contoso Support", got.CallerDisplayName)
+	require.Equal(t, "12345", got.CustomContext.SipHeaders["X-MS-Custom-Ticket"])
+	require.Equal(t, "contoso", got.CustomContext.VoipHeaders["customer"])
+	require.NotEmpty(t, got.IncomingCallContext)
+}
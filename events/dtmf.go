@@ -0,0 +1,70 @@
+package events
+
+import (
+	"slices"
+	"sync"
+)
+
+// ToneSequence collects the tones of continuous DTMF recognition per call connection.
+// Events may arrive out of order or more than once, so the tones are ordered
+// by their sequence ID and duplicates are dropped. It is safe for concurrent use.
+type ToneSequence struct {
+	mu    sync.Mutex
+	calls map[string]map[int]Tone
+}
+
+// NewToneSequence returns a new ToneSequence.
+func NewToneSequence() *ToneSequence {
+	return &ToneSequence{
+		calls: map[string]map[int]Tone{},
+	}
+}
+
+// Add adds the tone of the event and returns the tones of its call connection so far.
+func (s *ToneSequence) Add(e *MicrosoftCommunicationContinuousDtmfRecognitionToneReceived) Tones {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tones, ok := s.calls[e.CallConnectionID]
+	if !ok {
+		tones = map[int]Tone{}
+		s.calls[e.CallConnectionID] = tones
+	}
+	tones[e.SequenceID] = e.Tone
+
+	return s.tones(e.CallConnectionID)
+}
+
+// Tones returns the tones of the call connection in the order of their sequence ID.
+func (s *ToneSequence) Tones(callConnectionID string) Tones {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tones(callConnectionID)
+}
+
+// Reset removes the tones of the call connection,
+// like after the call is disconnected or an escape key is handled.
+func (s *ToneSequence) Reset(callConnectionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.calls, callConnectionID)
+}
+
+func (s *ToneSequence) tones(callConnectionID string) Tones {
+	tones := s.calls[callConnectionID]
+
+	ids := make([]int, 0, len(tones))
+	for id := range tones {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	seq := make(Tones, 0, len(ids))
+	for _, id := range ids {
+		seq = append(seq, tones[id])
+	}
+
+	return seq
+}
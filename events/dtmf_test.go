@@ -0,0 +1,33 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/calls"
+	"github.com/zeiss/go-acs/events"
+)
+
+func toneReceived(callConnectionID string, sequenceID int, tone events.Tone) *events.MicrosoftCommunicationContinuousDtmfRecognitionToneReceived {
+	return &events.MicrosoftCommunicationContinuousDtmfRecognitionToneReceived{
+		CallEvent:  events.CallEvent{CallConnectionID: callConnectionID},
+		SequenceID: sequenceID,
+		Tone:       tone,
+	}
+}
+
+func TestToneSequence(t *testing.T) {
+	s := events.NewToneSequence()
+
+	require.Equal(t, "1", s.Add(toneReceived("call-1", 1, calls.ToneOne)).String())
+	require.Equal(t, "1*", s.Add(toneReceived("call-1", 3, calls.ToneStar)).String())
+	require.Equal(t, "2", s.Add(toneReceived("call-2", 1, calls.ToneTwo)).String())
+
+	// Late and duplicate events keep the order of the sequence.
+	require.Equal(t, "12*", s.Add(toneReceived("call-1", 2, calls.ToneTwo)).String())
+	require.Equal(t, "12*", s.Add(toneReceived("call-1", 3, calls.ToneStar)).String())
+
+	s.Reset("call-1")
+	require.Empty(t, s.Tones("call-1"))
+	require.Equal(t, "2", s.Tones("call-2").String())
+}
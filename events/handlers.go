@@ -7,7 +7,6 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go"
 	"github.com/zeiss/pkg/channels"
@@ -29,7 +28,9 @@ func Filter(input <-chan cloudevents.Event, types ...string) <-chan cloudevents.
 
 // EventHandler is the handler for events.
 type EventHandler struct {
-	events chan cloudevents.Event
+	events         chan cloudevents.Event
+	allowedOrigins []string
+	authenticator  Authenticator
 }
 
 // Opt is the option for event handler.
@@ -63,63 +64,36 @@ func (h *EventHandler) Close() {
 
 // ServeHTTP is the handler for events.
 func (h *EventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodOptions {
+		h.serveWebHookValidation(w, r)
+		return
+	}
+
 	r.Body = http.MaxBytesReader(w, r.Body, 1048576)
 
-	dec := json.NewDecoder(r.Body)
-	dec.DisallowUnknownFields()
+	if r.Header.Get(HeaderEventGridEventType) == EventGridEventTypeSubscriptionValidation {
+		h.serveSubscriptionValidation(w, r)
+		return
+	}
 
-	var events []cloudevents.Event
-	err := dec.Decode(&events)
-	if utilx.NotEmpty(err) {
-		var syntaxError *json.SyntaxError
-		var unmarshalTypeError *json.UnmarshalTypeError
-
-		switch {
-		// Catch any syntax errors in the JSON and send an error message
-		// which interpolates the location of the problem to make it
-		// easier for the client to fix.
-		case errors.As(err, &syntaxError):
-			msg := fmt.Sprintf("Request body contains badly-formed JSON (at position %d)", syntaxError.Offset)
-			http.Error(w, msg, http.StatusBadRequest)
-
-		// In some circumstances Decode() may also return an
-		// io.ErrUnexpectedEOF error for syntax errors in the JSON. There
-		// is an open issue regarding this at
-		// https://github.com/golang/go/issues/25956.
-		case errors.Is(err, io.ErrUnexpectedEOF):
-			http.Error(w, "Request body contains badly-formed JSON", http.StatusBadRequest)
-
-		// Catch any type errors, like trying to assign a string in the payload.
-		case errors.As(err, &unmarshalTypeError):
-			msg := fmt.Sprintf("Request body contains an invalid value for the %q field (at position %d)", unmarshalTypeError.Field, unmarshalTypeError.Offset)
-			http.Error(w, msg, http.StatusBadRequest)
-
-		// Catch the error caused by extra unexpected fields in the request
-		// body. We extract the field name from the error message and
-		// interpolate it in our custom error message. There is an open
-		// issue at https://github.com/golang/go/issues/29035 regarding
-		// turning this into a sentinel error.
-		case strings.HasPrefix(err.Error(), "json: unknown field "):
-			fieldName := strings.TrimPrefix(err.Error(), "json: unknown field ")
-			http.Error(w, fmt.Sprintf("Request body contains unknown field %s", fieldName), http.StatusBadRequest)
-		case errors.Is(err, io.EOF):
-			http.Error(w, "Request body must not be empty", http.StatusBadRequest)
-
-		// Catch the error caused by the request body being too large. Again
-		// there is an open issue regarding turning this into a sentinel
-		// error at https://github.com/golang/go/issues/30715.
-		case err.Error() == "http: request body too large":
-			http.Error(w, "Request body must not be larger than 1MB", http.StatusRequestEntityTooLarge)
-		default:
-			log.Print(err.Error())
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	if h.authenticator != nil {
+		if err := h.authenticator.Authenticate(r); err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+			return
 		}
-		return
 	}
 
-	err = dec.Decode(&struct{}{})
-	if !errors.Is(err, io.EOF) {
-		http.Error(w, "Request body must only contain a single JSON object", http.StatusBadRequest)
+	body, err := io.ReadAll(r.Body)
+
+	var events []cloudevents.Event
+	if utilx.Empty(err) {
+		events, err = ParseEvents(r.Header.Get("Content-Type"), body)
+	}
+
+	if utilx.NotEmpty(err) {
+		writeError(w, err)
 		return
 	}
 
@@ -130,6 +104,42 @@ func (h *EventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusAccepted)
 }
 
+// writeError writes the error of decoding the events as response.
+func writeError(w http.ResponseWriter, err error) {
+	var syntaxError *json.SyntaxError
+	var unmarshalTypeError *json.UnmarshalTypeError
+
+	switch {
+	// Catch any syntax errors in the JSON and send an error message
+	// which interpolates the location of the problem to make it
+	// easier for the client to fix.
+	case errors.As(err, &syntaxError):
+		msg := fmt.Sprintf("Request body contains badly-formed JSON (at position %d)", syntaxError.Offset)
+		http.Error(w, msg, http.StatusBadRequest)
+
+	// Catch any type errors, like trying to assign a string in the payload.
+	case errors.As(err, &unmarshalTypeError):
+		msg := fmt.Sprintf("Request body contains an invalid value for the %q field (at position %d)", unmarshalTypeError.Field, unmarshalTypeError.Offset)
+		http.Error(w, msg, http.StatusBadRequest)
+
+	// Catch events that are neither in the CloudEvents nor in the Event Grid schema,
+	// or that are missing required attributes.
+	case errors.Is(err, ErrUnknownSchema), errors.Is(err, ErrInvalidEvent):
+		http.Error(w, fmt.Sprintf("Request body contains an invalid event: %s", err), http.StatusBadRequest)
+	case errors.Is(err, io.EOF):
+		http.Error(w, "Request body must not be empty", http.StatusBadRequest)
+
+	// Catch the error caused by the request body being too large. Again
+	// there is an open issue regarding turning this into a sentinel
+	// error at https://github.com/golang/go/issues/30715.
+	case err.Error() == "http: request body too large":
+		http.Error(w, "Request body must not be larger than 1MB", http.StatusRequestEntityTooLarge)
+	default:
+		log.Print(err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	}
+}
+
 // NewEventHandler creates a new event handler.
 func NewEventHandler(opts ...Opt) *EventHandler {
 	e := &EventHandler{}
@@ -0,0 +1,52 @@
+package events
+
+import "time"
+
+// EventTypeRecordingFileStatusUpdated is the type of the Microsoft.Communication.RecordingFileStatusUpdated event.
+const EventTypeRecordingFileStatusUpdated = "Microsoft.Communication.RecordingFileStatusUpdated"
+
+// MicrosoftCommunicationRecordingFileStatusUpdated is the data type of the event.
+// This parses the data of the Microsoft.Communication.RecordingFileStatusUpdated event.
+type MicrosoftCommunicationRecordingFileStatusUpdated struct {
+	// RecordingStorageInfo are the files of the recording.
+	RecordingStorageInfo RecordingStorageInfo `json:"recordingStorageInfo"`
+	// RecordingStartTime is the time the recording started.
+	RecordingStartTime time.Time `json:"recordingStartTime"`
+	// RecordingDurationMs is the duration of the recording in milliseconds.
+	RecordingDurationMs int64 `json:"recordingDurationMs"`
+	// RecordingContentType is the content of the recording.
+	RecordingContentType string `json:"recordingContentType"`
+	// RecordingChannelType is the channel of the recording.
+	RecordingChannelType string `json:"recordingChannelType"`
+	// RecordingFormatType is the format of the recording.
+	RecordingFormatType string `json:"recordingFormatType"`
+	// SessionEndReason is the reason the recording session ended.
+	SessionEndReason string `json:"sessionEndReason"`
+}
+
+// RecordingDuration returns the duration of the recording.
+func (e *MicrosoftCommunicationRecordingFileStatusUpdated) RecordingDuration() time.Duration {
+	return time.Duration(e.RecordingDurationMs) * time.Millisecond
+}
+
+// RecordingStorageInfo are the files of a recording.
+type RecordingStorageInfo struct {
+	// RecordingChunks are the chunks of the recording, in order.
+	RecordingChunks []RecordingChunk `json:"recordingChunks"`
+}
+
+// RecordingChunk is a file of a recording.
+type RecordingChunk struct {
+	// DocumentID is the ID of the file.
+	DocumentID string `json:"documentId"`
+	// Index is the index of the chunk in the recording.
+	Index int `json:"index"`
+	// EndReason is the reason the chunk ended.
+	EndReason string `json:"endReason"`
+	// ContentLocation is the location to download the content of the chunk.
+	ContentLocation string `json:"contentLocation"`
+	// MetadataLocation is the location to download the metadata of the chunk.
+	MetadataLocation string `json:"metadataLocation"`
+	// DeleteLocation is the location to delete the chunk.
+	DeleteLocation string `json:"deleteLocation"`
+}
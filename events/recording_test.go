@@ -0,0 +1,38 @@
+package events_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+)
+
+func TestMicrosoftCommunicationRecordingFileStatusUpdated(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "RecordingFileStatusUpdated.json"))
+	require.NoError(t, err)
+
+	ee, err := events.ParseEvents("application/json", b)
+	require.NoError(t, err)
+	require.Len(t, ee, 1)
+
+	var got *events.MicrosoftCommunicationRecordingFileStatusUpdated
+
+	r := events.NewRouter()
+	r.OnRecordingFileStatusUpdated(func(_ context.Context, data *events.MicrosoftCommunicationRecordingFileStatusUpdated) error {
+		got = data
+		return nil
+	})
+	require.NoError(t, r.Dispatch(t.Context(), ee[0]))
+
+	require.NotNil(t, got)
+	require.Equal(t, 6620*time.Millisecond, got.RecordingDuration())
+	require.Len(t, got.RecordingStorageInfo.RecordingChunks, 1)
+
+	chunk := got.RecordingStorageInfo.RecordingChunks[0]
+	require.Equal(t, "https://storage.asm.skype.com/v1/objects/0-eus-d12-801b3f3fc462fe8a01e6810cbff729b8/content/video", chunk.ContentLocation)
+	require.Equal(t, "https://storage.asm.skype.com/v1/objects/0-eus-d12-801b3f3fc462fe8a01e6810cbff729b8", chunk.DeleteLocation)
+}
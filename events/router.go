@@ -0,0 +1,392 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"runtime/debug"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go"
+)
+
+// ErrHandlerPanic is returned when a handler panics.
+var ErrHandlerPanic = errors.New("events: handler panicked")
+
+// HandlerFunc is the handler for an event.
+type HandlerFunc func(ctx context.Context, e cloudevents.Event) error
+
+// ErrorHandlerFunc is the handler for the errors of handlers.
+type ErrorHandlerFunc func(ctx context.Context, e cloudevents.Event, err error)
+
+// Router dispatches events to the handlers registered for their type.
+type Router struct {
+	mu          sync.RWMutex
+	handlers    map[string]HandlerFunc
+	fallback    HandlerFunc
+	onError     ErrorHandlerFunc
+	concurrency int
+}
+
+// RouterOpt is the option for the router.
+type RouterOpt func(*Router)
+
+// WithFallback sets the handler for events without a registered handler.
+// Events without a handler are dropped by default.
+func WithFallback(fn HandlerFunc) RouterOpt {
+	return func(r *Router) {
+		r.fallback = fn
+	}
+}
+
+// WithErrorHandler sets the handler for the errors of handlers.
+// Errors are logged by default.
+func WithErrorHandler(fn ErrorHandlerFunc) RouterOpt {
+	return func(r *Router) {
+		r.onError = fn
+	}
+}
+
+// WithConcurrency sets the number of events that are handled concurrently.
+// Events of the same call connection are always handled in order.
+func WithConcurrency(n int) RouterOpt {
+	return func(r *Router) {
+		if n > 0 {
+			r.concurrency = n
+		}
+	}
+}
+
+// NewRouter returns a new router.
+func NewRouter(opts ...RouterOpt) *Router {
+	r := &Router{
+		handlers:    map[string]HandlerFunc{},
+		concurrency: 1,
+		onError: func(_ context.Context, e cloudevents.Event, err error) {
+			log.Printf("events: handling %s event %s: %v", e.Type(), e.ID(), err)
+		},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Handle registers the handler for the event type.
+// It replaces the handler that is registered for the type.
+func (r *Router) Handle(eventType string, fn HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[eventType] = fn
+}
+
+// On registers a handler that receives the decoded data of the event type.
+func On[T any](r *Router, eventType string, fn func(ctx context.Context, data *T) error) {
+	r.Handle(eventType, func(ctx context.Context, e cloudevents.Event) error {
+		data := new(T)
+		if err := e.DataAs(data); err != nil {
+			return fmt.Errorf("events: decoding %s event: %w", e.Type(), err)
+		}
+
+		return fn(ctx, data)
+	})
+}
+
+// ForOperation wraps a handler so that it only receives the events of the operation with the operation context.
+// Events of other operations are ignored.
+//
+//	r.OnPlayCanceled(events.ForOperation("hold-music", func(ctx context.Context, data *events.MicrosoftCommunicationPlayCanceled) error {
+//		return nil
+//	}))
+func ForOperation[T CallEventData](operationContext string, fn func(ctx context.Context, data T) error) func(ctx context.Context, data T) error {
+	return func(ctx context.Context, data T) error {
+		if data.GetCallEvent().OperationContext != operationContext {
+			return nil
+		}
+
+		return fn(ctx, data)
+	}
+}
+
+// OnCallConnected registers the handler for the Microsoft.Communication.CallConnected event.
+func (r *Router) OnCallConnected(fn func(ctx context.Context, data *MicrosoftCommunicationCallConnected) error) {
+	On(r, EventTypeCallConnected, fn)
+}
+
+// OnCallDisconnected registers the handler for the Microsoft.Communication.CallDisconnected event.
+func (r *Router) OnCallDisconnected(fn func(ctx context.Context, data *MicrosoftCommunicationCallDisconnected) error) {
+	On(r, EventTypeCallDisconnected, fn)
+}
+
+// OnCallTransferAccepted registers the handler for the Microsoft.Communication.CallTransferAccepted event.
+func (r *Router) OnCallTransferAccepted(fn func(ctx context.Context, data *MicrosoftCommunicationCallTransferAccepted) error) {
+	On(r, EventTypeCallTransferAccepted, fn)
+}
+
+// OnCallTransferFailed registers the handler for the Microsoft.Communication.CallTransferFailed event.
+func (r *Router) OnCallTransferFailed(fn func(ctx context.Context, data *MicrosoftCommunicationCallTransferFailed) error) {
+	On(r, EventTypeCallTransferFailed, fn)
+}
+
+// OnParticipantsUpdated registers the handler for the Microsoft.Communication.ParticipantsUpdated event.
+func (r *Router) OnParticipantsUpdated(fn func(ctx context.Context, data *MicrosoftCommunicationParticipantsUpdated) error) {
+	On(r, EventTypeParticipantsUpdated, fn)
+}
+
+// OnAddParticipantSucceeded registers the handler for the Microsoft.Communication.AddParticipantSucceeded event.
+func (r *Router) OnAddParticipantSucceeded(fn func(ctx context.Context, data *MicrosoftCommunicationAddParticipantSucceeded) error) {
+	On(r, EventTypeAddParticipantSucceeded, fn)
+}
+
+// OnAddParticipantFailed registers the handler for the Microsoft.Communication.AddParticipantFailed event.
+func (r *Router) OnAddParticipantFailed(fn func(ctx context.Context, data *MicrosoftCommunicationAddParticipantFailed) error) {
+	On(r, EventTypeAddParticipantFailed, fn)
+}
+
+// OnRemoveParticipantSucceeded registers the handler for the Microsoft.Communication.RemoveParticipantSucceeded event.
+func (r *Router) OnRemoveParticipantSucceeded(fn func(ctx context.Context, data *MicrosoftCommunicationRemoveParticipantSucceeded) error) {
+	On(r, EventTypeRemoveParticipantSucceeded, fn)
+}
+
+// OnRemoveParticipantFailed registers the handler for the Microsoft.Communication.RemoveParticipantFailed event.
+func (r *Router) OnRemoveParticipantFailed(fn func(ctx context.Context, data *MicrosoftCommunicationRemoveParticipantFailed) error) {
+	On(r, EventTypeRemoveParticipantFailed, fn)
+}
+
+// OnCancelAddParticipantSucceeded registers the handler for the Microsoft.Communication.CancelAddParticipantSucceeded event.
+func (r *Router) OnCancelAddParticipantSucceeded(fn func(ctx context.Context, data *MicrosoftCommunicationCancelAddParticipantSucceeded) error) {
+	On(r, EventTypeCancelAddParticipantSucceeded, fn)
+}
+
+// OnCancelAddParticipantFailed registers the handler for the Microsoft.Communication.CancelAddParticipantFailed event.
+func (r *Router) OnCancelAddParticipantFailed(fn func(ctx context.Context, data *MicrosoftCommunicationCancelAddParticipantFailed) error) {
+	On(r, EventTypeCancelAddParticipantFailed, fn)
+}
+
+// OnPlayCompleted registers the handler for the Microsoft.Communication.PlayCompleted event.
+func (r *Router) OnPlayCompleted(fn func(ctx context.Context, data *MicrosoftCommunicationPlayCompleted) error) {
+	On(r, EventTypePlayCompleted, fn)
+}
+
+// OnPlayFailed registers the handler for the Microsoft.Communication.PlayFailed event.
+func (r *Router) OnPlayFailed(fn func(ctx context.Context, data *MicrosoftCommunicationPlayFailed) error) {
+	On(r, EventTypePlayFailed, fn)
+}
+
+// OnPlayCanceled registers the handler for the Microsoft.Communication.PlayCanceled event.
+func (r *Router) OnPlayCanceled(fn func(ctx context.Context, data *MicrosoftCommunicationPlayCanceled) error) {
+	On(r, EventTypePlayCanceled, fn)
+}
+
+// OnRecognizeCompleted registers the handler for the Microsoft.Communication.RecognizeCompleted event.
+func (r *Router) OnRecognizeCompleted(fn func(ctx context.Context, data *MicrosoftCommunicationRecognizeCompleted) error) {
+	On(r, EventTypeRecognizeCompleted, fn)
+}
+
+// OnRecognizeFailed registers the handler for the Microsoft.Communication.RecognizeFailed event.
+func (r *Router) OnRecognizeFailed(fn func(ctx context.Context, data *MicrosoftCommunicationRecognizeFailed) error) {
+	On(r, EventTypeRecognizeFailed, fn)
+}
+
+// OnRecognizeCanceled registers the handler for the Microsoft.Communication.RecognizeCanceled event.
+func (r *Router) OnRecognizeCanceled(fn func(ctx context.Context, data *MicrosoftCommunicationRecognizeCanceled) error) {
+	On(r, EventTypeRecognizeCanceled, fn)
+}
+
+// OnContinuousDtmfRecognitionToneReceived registers the handler for the Microsoft.Communication.ContinuousDtmfRecognitionToneReceived event.
+func (r *Router) OnContinuousDtmfRecognitionToneReceived(fn func(ctx context.Context, data *MicrosoftCommunicationContinuousDtmfRecognitionToneReceived) error) {
+	On(r, EventTypeContinuousDtmfRecognitionToneReceived, fn)
+}
+
+// OnContinuousDtmfRecognitionToneFailed registers the handler for the Microsoft.Communication.ContinuousDtmfRecognitionToneFailed event.
+func (r *Router) OnContinuousDtmfRecognitionToneFailed(fn func(ctx context.Context, data *MicrosoftCommunicationContinuousDtmfRecognitionToneFailed) error) {
+	On(r, EventTypeContinuousDtmfRecognitionToneFailed, fn)
+}
+
+// OnContinuousDtmfRecognitionStopped registers the handler for the Microsoft.Communication.ContinuousDtmfRecognitionStopped event.
+func (r *Router) OnContinuousDtmfRecognitionStopped(fn func(ctx context.Context, data *MicrosoftCommunicationContinuousDtmfRecognitionStopped) error) {
+	On(r, EventTypeContinuousDtmfRecognitionStopped, fn)
+}
+
+// OnSendDtmfTonesCompleted registers the handler for the Microsoft.Communication.SendDtmfTonesCompleted event.
+func (r *Router) OnSendDtmfTonesCompleted(fn func(ctx context.Context, data *MicrosoftCommunicationSendDtmfTonesCompleted) error) {
+	On(r, EventTypeSendDtmfTonesCompleted, fn)
+}
+
+// OnSendDtmfTonesFailed registers the handler for the Microsoft.Communication.SendDtmfTonesFailed event.
+func (r *Router) OnSendDtmfTonesFailed(fn func(ctx context.Context, data *MicrosoftCommunicationSendDtmfTonesFailed) error) {
+	On(r, EventTypeSendDtmfTonesFailed, fn)
+}
+
+// OnRecordingStateChanged registers the handler for the Microsoft.Communication.RecordingStateChanged event.
+func (r *Router) OnRecordingStateChanged(fn func(ctx context.Context, data *MicrosoftCommunicationRecordingStateChanged) error) {
+	On(r, EventTypeRecordingStateChanged, fn)
+}
+
+// OnTranscriptionStarted registers the handler for the Microsoft.Communication.TranscriptionStarted event.
+func (r *Router) OnTranscriptionStarted(fn func(ctx context.Context, data *MicrosoftCommunicationTranscriptionStarted) error) {
+	On(r, EventTypeTranscriptionStarted, fn)
+}
+
+// OnTranscriptionStopped registers the handler for the Microsoft.Communication.TranscriptionStopped event.
+func (r *Router) OnTranscriptionStopped(fn func(ctx context.Context, data *MicrosoftCommunicationTranscriptionStopped) error) {
+	On(r, EventTypeTranscriptionStopped, fn)
+}
+
+// OnTranscriptionUpdated registers the handler for the Microsoft.Communication.TranscriptionUpdated event.
+func (r *Router) OnTranscriptionUpdated(fn func(ctx context.Context, data *MicrosoftCommunicationTranscriptionUpdated) error) {
+	On(r, EventTypeTranscriptionUpdated, fn)
+}
+
+// OnTranscriptionFailed registers the handler for the Microsoft.Communication.TranscriptionFailed event.
+func (r *Router) OnTranscriptionFailed(fn func(ctx context.Context, data *MicrosoftCommunicationTranscriptionFailed) error) {
+	On(r, EventTypeTranscriptionFailed, fn)
+}
+
+// OnMediaStreamingStarted registers the handler for the Microsoft.Communication.MediaStreamingStarted event.
+func (r *Router) OnMediaStreamingStarted(fn func(ctx context.Context, data *MicrosoftCommunicationMediaStreamingStarted) error) {
+	On(r, EventTypeMediaStreamingStarted, fn)
+}
+
+// OnMediaStreamingStopped registers the handler for the Microsoft.Communication.MediaStreamingStopped event.
+func (r *Router) OnMediaStreamingStopped(fn func(ctx context.Context, data *MicrosoftCommunicationMediaStreamingStopped) error) {
+	On(r, EventTypeMediaStreamingStopped, fn)
+}
+
+// OnMediaStreamingFailed registers the handler for the Microsoft.Communication.MediaStreamingFailed event.
+func (r *Router) OnMediaStreamingFailed(fn func(ctx context.Context, data *MicrosoftCommunicationMediaStreamingFailed) error) {
+	On(r, EventTypeMediaStreamingFailed, fn)
+}
+
+// OnHoldFailed registers the handler for the Microsoft.Communication.HoldFailed event.
+func (r *Router) OnHoldFailed(fn func(ctx context.Context, data *MicrosoftCommunicationHoldFailed) error) {
+	On(r, EventTypeHoldFailed, fn)
+}
+
+// OnAnswerFailed registers the handler for the Microsoft.Communication.AnswerFailed event.
+func (r *Router) OnAnswerFailed(fn func(ctx context.Context, data *MicrosoftCommunicationAnswerFailed) error) {
+	On(r, EventTypeAnswerFailed, fn)
+}
+
+// OnRecordingFileStatusUpdated registers the handler for the Microsoft.Communication.RecordingFileStatusUpdated event.
+func (r *Router) OnRecordingFileStatusUpdated(fn func(ctx context.Context, data *MicrosoftCommunicationRecordingFileStatusUpdated) error) {
+	On(r, EventTypeRecordingFileStatusUpdated, fn)
+}
+
+// OnIncomingCall registers the handler for the Microsoft.Communication.IncomingCall event.
+func (r *Router) OnIncomingCall(fn func(ctx context.Context, data *MicrosoftCommunicationIncomingCall) error) {
+	On(r, EventTypeIncomingCall, fn)
+}
+
+// OnSMSReceived registers the handler for the Microsoft.Communication.SMSReceived event.
+func (r *Router) OnSMSReceived(fn func(ctx context.Context, data *MicrosoftCommunicationSMSReceived) error) {
+	On(r, EventTypeSMSReceived, fn)
+}
+
+// OnSMSDeliveryReportReceived registers the handler for the Microsoft.Communication.SMSDeliveryReportReceived event.
+func (r *Router) OnSMSDeliveryReportReceived(fn func(ctx context.Context, data *MicrosoftCommunicationSMSDeliveryReportReceived) error) {
+	On(r, EventTypeSMSDeliveryReportReceived, fn)
+}
+
+// Dispatch handles the event with the handler registered for its type, or the fallback.
+// A panic of the handler is returned as an error.
+func (r *Router) Dispatch(ctx context.Context, e cloudevents.Event) (err error) {
+	r.mu.RLock()
+	fn, ok := r.handlers[e.Type()]
+	if !ok {
+		fn = r.fallback
+	}
+	r.mu.RUnlock()
+
+	if fn == nil {
+		return nil
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("%w: %v\n%s", ErrHandlerPanic, v, debug.Stack())
+		}
+	}()
+
+	return fn(ctx, e)
+}
+
+// Run dispatches the events of the channel until it is closed or the context is done.
+// Errors of the handlers are passed to the error handler.
+func (r *Router) Run(ctx context.Context, events <-chan cloudevents.Event) error {
+	workers := make([]chan cloudevents.Event, r.concurrency)
+
+	var wg sync.WaitGroup
+
+	for i := range workers {
+		workers[i] = make(chan cloudevents.Event)
+
+		wg.Add(1)
+		go func(in <-chan cloudevents.Event) {
+			defer wg.Done()
+
+			for e := range in {
+				if err := r.Dispatch(ctx, e); err != nil {
+					r.onError(ctx, e, err)
+				}
+			}
+		}(workers[i])
+	}
+
+	defer func() {
+		for _, w := range workers {
+			close(w)
+		}
+		wg.Wait()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			select {
+			case workers[partition(e, len(workers))] <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// partition returns the worker of the event. Events of the same call
+// connection, or the same subject, are handled by the same worker.
+func partition(e cloudevents.Event, n int) int {
+	if n == 1 {
+		return 0
+	}
+
+	key := e.Subject()
+
+	data := struct {
+		CallConnectionID string `json:"callConnectionId"`
+	}{}
+
+	if b, err := e.DataBytes(); err == nil && json.Unmarshal(b, &data) == nil && data.CallConnectionID != "" {
+		key = data.CallConnectionID
+	}
+
+	if key == "" {
+		key = e.ID()
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+
+	return int(h.Sum32() % uint32(n))
+}
@@ -0,0 +1,154 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go"
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+)
+
+func newEvent(t *testing.T, id, typ string, data any) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetType(typ)
+	e.SetSource("test")
+	e.SetDataContentType(cloudevents.ApplicationJSON)
+	require.NoError(t, e.SetData(data))
+
+	return e
+}
+
+func TestRouter_Dispatch(t *testing.T) {
+	r := events.NewRouter()
+
+	var connected *events.MicrosoftCommunicationCallConnected
+	r.OnCallConnected(func(ctx context.Context, data *events.MicrosoftCommunicationCallConnected) error {
+		connected = data
+		return nil
+	})
+
+	var recognized *events.MicrosoftCommunicationRecognizeCompleted
+	r.OnRecognizeCompleted(func(ctx context.Context, data *events.MicrosoftCommunicationRecognizeCompleted) error {
+		recognized = data
+		return nil
+	})
+
+	err := r.Dispatch(t.Context(), newEvent(t, "1", events.EventTypeCallConnected, map[string]string{"callConnectionId": "call-1"}))
+	require.NoError(t, err)
+	require.Equal(t, "call-1", connected.CallConnectionID)
+
+	err = r.Dispatch(t.Context(), newEvent(t, "2", events.EventTypeRecognizeCompleted, map[string]any{
+		"callConnectionId": "call-1",
+		"recognitionType":  "choices",
+		"choiceResult":     map[string]string{"label": "Acknowledged"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "Acknowledged", recognized.ChoiceResult.Label)
+
+	// Events without a handler and without a fallback are dropped.
+	require.NoError(t, r.Dispatch(t.Context(), newEvent(t, "3", "Unknown", map[string]string{})))
+}
+
+func TestForOperation(t *testing.T) {
+	r := events.NewRouter()
+
+	var canceled []string
+	r.OnPlayCanceled(events.ForOperation("hold-music", func(ctx context.Context, data *events.MicrosoftCommunicationPlayCanceled) error {
+		canceled = append(canceled, data.CallConnectionID)
+		return nil
+	}))
+
+	require.NoError(t, r.Dispatch(t.Context(), newEvent(t, "1", events.EventTypePlayCanceled, map[string]string{"callConnectionId": "call-1", "operationContext": "prompt"})))
+	require.NoError(t, r.Dispatch(t.Context(), newEvent(t, "2", events.EventTypePlayCanceled, map[string]string{"callConnectionId": "call-2", "operationContext": "hold-music"})))
+	require.Equal(t, []string{"call-2"}, canceled)
+}
+
+func TestRouter_Fallback(t *testing.T) {
+	var types []string
+
+	r := events.NewRouter(events.WithFallback(func(ctx context.Context, e cloudevents.Event) error {
+		types = append(types, e.Type())
+		return nil
+	}))
+
+	require.NoError(t, r.Dispatch(t.Context(), newEvent(t, "1", "Unknown", map[string]string{})))
+	require.Equal(t, []string{"Unknown"}, types)
+}
+
+func TestRouter_Panic(t *testing.T) {
+	r := events.NewRouter()
+	r.OnCallConnected(func(ctx context.Context, data *events.MicrosoftCommunicationCallConnected) error {
+		panic("boom")
+	})
+
+	err := r.Dispatch(t.Context(), newEvent(t, "1", events.EventTypeCallConnected, map[string]string{}))
+	require.ErrorIs(t, err, events.ErrHandlerPanic)
+}
+
+func TestRouter_Run(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string][]int{}
+	errs := 0
+
+	r := events.NewRouter(
+		events.WithConcurrency(4),
+		events.WithErrorHandler(func(ctx context.Context, e cloudevents.Event, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs++
+		}),
+	)
+
+	events.On(r, events.EventTypeCallConnected, func(ctx context.Context, data *struct {
+		CallConnectionID string `json:"callConnectionId"`
+		Sequence         int    `json:"sequence"`
+	},
+	) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		seen[data.CallConnectionID] = append(seen[data.CallConnectionID], data.Sequence)
+
+		if data.Sequence == 0 {
+			return errors.New("first")
+		}
+
+		return nil
+	})
+
+	in := make(chan cloudevents.Event)
+
+	done := make(chan error)
+	go func() {
+		done <- r.Run(t.Context(), in)
+	}()
+
+	for i := range 50 {
+		for c := range 5 {
+			in <- newEvent(t, fmt.Sprintf("%d-%d", c, i), events.EventTypeCallConnected, map[string]any{
+				"callConnectionId": fmt.Sprintf("call-%d", c),
+				"sequence":         i,
+			})
+		}
+	}
+	close(in)
+
+	require.NoError(t, <-done)
+	require.Equal(t, 5, errs)
+
+	for c := range 5 {
+		seq := seen[fmt.Sprintf("call-%d", c)]
+		require.Len(t, seq, 50)
+
+		for i, s := range seq {
+			require.Equal(t, i, s)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go"
+)
+
+const (
+	// ContentTypeCloudEventsJSON is the content type of a single event in the CloudEvents schema.
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+	// ContentTypeCloudEventsBatchJSON is the content type of a batch of events in the CloudEvents schema.
+	ContentTypeCloudEventsBatchJSON = "application/cloudevents-batch+json"
+)
+
+// Schema is the schema of a delivered event.
+type Schema string
+
+const (
+	// SchemaCloudEvents is the CloudEvents 1.0 schema.
+	SchemaCloudEvents Schema = "CloudEvents"
+	// SchemaEventGrid is the native Event Grid schema.
+	SchemaEventGrid Schema = "EventGrid"
+)
+
+var (
+	// ErrUnknownSchema is returned when the schema of an event can not be detected.
+	ErrUnknownSchema = errors.New("events: unknown event schema")
+	// ErrInvalidEvent is returned when an event can not be decoded.
+	ErrInvalidEvent = errors.New("events: invalid event")
+)
+
+// EventGridEvent is an event in the native Event Grid schema.
+type EventGridEvent struct {
+	// ID is the ID of the event.
+	ID string `json:"id"`
+	// Topic is the resource path of the event source.
+	Topic string `json:"topic,omitempty"`
+	// Subject is the publisher-defined path to the event subject.
+	Subject string `json:"subject"`
+	// EventType is the type of the event.
+	EventType string `json:"eventType"`
+	// EventTime is the time the event was generated.
+	EventTime time.Time `json:"eventTime"`
+	// Data is the data of the event.
+	Data json.RawMessage `json:"data,omitempty"`
+	// DataVersion is the schema version of the data.
+	DataVersion string `json:"dataVersion,omitempty"`
+	// MetadataVersion is the schema version of the event metadata.
+	MetadataVersion string `json:"metadataVersion,omitempty"`
+}
+
+// CloudEvent converts the event into a CloudEvent.
+// The topic becomes the source and the data version the dataversion extension.
+func (e *EventGridEvent) CloudEvent() (cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	event.SetID(e.ID)
+	event.SetType(e.EventType)
+	event.SetSource(e.Topic)
+	event.SetSubject(e.Subject)
+	event.SetTime(e.EventTime)
+
+	if e.DataVersion != "" {
+		event.SetExtension("dataversion", e.DataVersion)
+	}
+
+	if len(e.Data) > 0 {
+		event.SetDataContentType(cloudevents.ApplicationJSON)
+
+		if err := event.SetData(e.Data); err != nil {
+			return event, err
+		}
+	}
+
+	return event, nil
+}
+
+// DetectSchema returns the schema of a single event.
+func DetectSchema(data []byte) (Schema, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+
+	switch {
+	case fields["specversion"] != nil:
+		return SchemaCloudEvents, nil
+	case fields["eventType"] != nil:
+		return SchemaEventGrid, nil
+	}
+
+	return "", ErrUnknownSchema
+}
+
+// ParseEvents parses a single event or a batch of events in either schema.
+// The content type forces the CloudEvents schema, otherwise the schema is
+// detected from the payload of each event.
+func ParseEvents(contentType string, body []byte) ([]cloudevents.Event, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, io.EOF
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	raws := []json.RawMessage{}
+
+	switch {
+	case mediaType == ContentTypeCloudEventsJSON:
+		raws = append(raws, body)
+	case mediaType == ContentTypeCloudEventsBatchJSON, body[0] == '[':
+		if err := json.Unmarshal(body, &raws); err != nil {
+			return nil, err
+		}
+	default:
+		if !json.Valid(body) {
+			return nil, json.Unmarshal(body, &struct{}{})
+		}
+		raws = append(raws, body)
+	}
+
+	events := make([]cloudevents.Event, 0, len(raws))
+
+	for i, raw := range raws {
+		schema := SchemaCloudEvents
+		if mediaType != ContentTypeCloudEventsJSON && mediaType != ContentTypeCloudEventsBatchJSON {
+			s, err := DetectSchema(raw)
+			if err != nil {
+				return nil, fmt.Errorf("event %d: %w", i, err)
+			}
+			schema = s
+		}
+
+		event, err := parseEvent(schema, raw)
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w: %v", i, ErrInvalidEvent, err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// parseEvent parses a single event of the schema. The event has to have
+// the required attributes of a CloudEvent, like an id, source and type.
+func parseEvent(schema Schema, raw []byte) (cloudevents.Event, error) {
+	event := cloudevents.Event{}
+
+	if schema == SchemaEventGrid {
+		e := &EventGridEvent{}
+		if err := json.Unmarshal(raw, e); err != nil {
+			return event, err
+		}
+
+		var err error
+		event, err = e.CloudEvent()
+		if err != nil {
+			return event, err
+		}
+	} else if err := json.Unmarshal(raw, &event); err != nil {
+		return event, err
+	}
+
+	if err := event.Validate(); err != nil {
+		return event, err
+	}
+
+	return event, nil
+}
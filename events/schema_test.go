@@ -0,0 +1,130 @@
+package events_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go"
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+)
+
+const eventGridSMSReceived = `{
+	"id": "Incoming_20200918002745d29ebbea-3341-4466-9690-0a03af35228e",
+	"topic": "/subscriptions/50ad1522-5c2c-4d9a-a6c8-67c11ecb75b8/resourcegroups/acse2e/providers/microsoft.communication/communicationservices/{communication-services-resource-name}",
+	"subject": "/phonenumber/15555555555",
+	"data": {
+		"MessageId": "Incoming_20200918002745d29ebbea-3341-4466-9690-0a03af35228e",
+		"From": "15555555555",
+		"To": "15555555555",
+		"Message": "Great to connect with ACS events",
+		"ReceivedTimestamp": "2020-09-18T00:27:45.32Z"
+	},
+	"eventType": "Microsoft.Communication.SMSReceived",
+	"dataVersion": "1.0",
+	"metadataVersion": "1",
+	"eventTime": "2020-09-18T00:27:47Z"
+}`
+
+const cloudEventCallConnected = `{
+	"id": "7dec6eed-129c-43f3-a2bf-33b4ae5ad5f0",
+	"source": "calling/callConnections/421f3500-f5de-4c12-bf61-9e2641433687",
+	"type": "Microsoft.Communication.CallConnected",
+	"data": {
+		"version": "2023-10-03",
+		"callConnectionId": "421f3500-f5de-4c12-bf61-9e2641433687",
+		"serverCallId": "aHR0cHM6Ly9hcGk",
+		"correlationId": "e5a5f5a5-2f5a-4a5f-8f5a-5f5a5f5a5f5a",
+		"publicEventType": "Microsoft.Communication.CallConnected"
+	},
+	"time": "2024-05-02T09:28:42.2417018+00:00",
+	"specversion": "1.0",
+	"datacontenttype": "application/json",
+	"subject": "calling/callConnections/421f3500-f5de-4c12-bf61-9e2641433687",
+	"unknownfield": "ignored"
+}`
+
+func TestEventHandler_Schemas(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		types       []string
+	}{
+		{name: "event grid batch", contentType: "application/json", body: "[" + eventGridSMSReceived + "]", types: []string{events.EventTypeSMSReceived}},
+		{name: "event grid single", contentType: "application/json", body: eventGridSMSReceived, types: []string{events.EventTypeSMSReceived}},
+		{name: "cloudevents single", contentType: events.ContentTypeCloudEventsJSON, body: cloudEventCallConnected, types: []string{"Microsoft.Communication.CallConnected"}},
+		{name: "cloudevents batch", contentType: events.ContentTypeCloudEventsBatchJSON + "; charset=utf-8", body: "[" + cloudEventCallConnected + "," + cloudEventCallConnected + "]", types: []string{"Microsoft.Communication.CallConnected", "Microsoft.Communication.CallConnected"}},
+		{name: "mixed", body: "[" + eventGridSMSReceived + "," + cloudEventCallConnected + "]", types: []string{events.EventTypeSMSReceived, "Microsoft.Communication.CallConnected"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			in := make(chan cloudevents.Event, len(tt.types))
+			rr := httptest.NewRecorder()
+			events.NewEventHandler(events.WithEvents(in)).ServeHTTP(rr, req)
+			require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+			for _, typ := range tt.types {
+				e := <-in
+				require.Equal(t, typ, e.Type())
+			}
+		})
+	}
+}
+
+func TestEventGridEvent_CloudEvent(t *testing.T) {
+	ee, err := events.ParseEvents("application/json", []byte(eventGridSMSReceived))
+	require.NoError(t, err)
+	require.Len(t, ee, 1)
+
+	e := ee[0]
+	require.Equal(t, "Incoming_20200918002745d29ebbea-3341-4466-9690-0a03af35228e", e.ID())
+	require.Equal(t, "/phonenumber/15555555555", e.Subject())
+	require.Equal(t, "2020-09-18T00:27:47Z", e.Time().UTC().Format("2006-01-02T15:04:05Z07:00"))
+	require.Equal(t, "1.0", e.Extensions()["dataversion"])
+
+	data := &events.MicrosoftCommunicationSMSReceived{}
+	require.NoError(t, e.DataAs(data))
+	require.Equal(t, "Great to connect with ACS events", data.Message)
+}
+
+func TestEventHandler_InvalidSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{name: "unknown schema", body: `[{"foo": "bar"}]`, code: http.StatusBadRequest},
+		{name: "malformed", body: `[{"foo": }]`, code: http.StatusBadRequest},
+		{name: "empty", body: ` `, code: http.StatusBadRequest},
+		{
+			name: "missing id",
+			body: "[" + strings.Replace(cloudEventCallConnected, `"id": "7dec6eed-129c-43f3-a2bf-33b4ae5ad5f0",`, "", 1) + "]",
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "empty topic",
+			body: "[" + strings.Replace(eventGridSMSReceived, `"topic": "/subscriptions/50ad1522-5c2c-4d9a-a6c8-67c11ecb75b8/resourcegroups/acse2e/providers/microsoft.communication/communicationservices/{communication-services-resource-name}"`, `"topic": ""`, 1) + "]",
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(tt.body))
+
+			rr := httptest.NewRecorder()
+			events.NewEventHandler().ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+		})
+	}
+
+	_, err := events.ParseEvents("", []byte(strings.Replace(cloudEventCallConnected, `"id": "7dec6eed-129c-43f3-a2bf-33b4ae5ad5f0",`, "", 1)))
+	require.ErrorIs(t, err, events.ErrInvalidEvent)
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zeiss/go-acs/sms"
+)
+
+const (
+	// EventTypeSMSReceived is the type of the Microsoft.Communication.SMSReceived event.
+	EventTypeSMSReceived = "Microsoft.Communication.SMSReceived"
+	// EventTypeSMSDeliveryReportReceived is the type of the Microsoft.Communication.SMSDeliveryReportReceived event.
+	EventTypeSMSDeliveryReportReceived = "Microsoft.Communication.SMSDeliveryReportReceived"
+)
+
+// MicrosoftCommunicationSMSReceived is the data type of the event.
+// This parses the data of the Microsoft.Communication.SMSReceived event.
+type MicrosoftCommunicationSMSReceived struct {
+	// MessageID is the ID of the message.
+	MessageID string `json:"messageId"`
+	// From is the phone number of the sender.
+	From string `json:"from"`
+	// To is the phone number of the recipient.
+	To string `json:"to"`
+	// Message is the message.
+	Message string `json:"message"`
+	// ReceivedTimestamp is the time the message was received.
+	ReceivedTimestamp time.Time `json:"receivedTimestamp"`
+}
+
+// MicrosoftCommunicationSMSDeliveryReportReceived is the data type of the event.
+// This parses the data of the Microsoft.Communication.SMSDeliveryReportReceived event.
+type MicrosoftCommunicationSMSDeliveryReportReceived struct {
+	// MessageID is the ID of the message.
+	// It matches the MessageID of the sms.SMSSendResponseItem of the sent message.
+	MessageID string `json:"messageId"`
+	// From is the phone number of the sender.
+	From string `json:"from"`
+	// To is the phone number of the recipient.
+	To string `json:"to"`
+	// DeliveryStatus is the status of the delivery.
+	DeliveryStatus DeliveryStatus `json:"deliveryStatus"`
+	// DeliveryStatusDetails are the details of the delivery status.
+	DeliveryStatusDetails string `json:"deliveryStatusDetails"`
+	// DeliveryAttempts are the attempts to deliver the message.
+	DeliveryAttempts []DeliveryAttempt `json:"deliveryAttempts"`
+	// ReceivedTimestamp is the time the delivery report was received.
+	ReceivedTimestamp time.Time `json:"receivedTimestamp"`
+	// Tag is the tag of the sent message.
+	Tag string `json:"tag,omitempty"`
+}
+
+// DeliveryStatus is the status of an SMS delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusDelivered is the status of a delivered message.
+	DeliveryStatusDelivered DeliveryStatus = "Delivered"
+	// DeliveryStatusFailed is the status of a message that could not be delivered.
+	DeliveryStatusFailed DeliveryStatus = "Failed"
+)
+
+// DeliveryAttempt is an attempt to deliver a message.
+type DeliveryAttempt struct {
+	// Timestamp is the time of the attempt.
+	Timestamp time.Time `json:"timestamp"`
+	// SegmentsSucceeded is the number of segments that were delivered.
+	SegmentsSucceeded int `json:"segmentsSucceeded"`
+	// SegmentsFailed is the number of segments that failed.
+	SegmentsFailed int `json:"segmentsFailed"`
+}
+
+// Delivered returns true if the message was delivered.
+func (e *MicrosoftCommunicationSMSDeliveryReportReceived) Delivered() bool {
+	return e.DeliveryStatus == DeliveryStatusDelivered
+}
+
+// DeliveryTracker links delivery reports to the sent messages.
+// It is safe for concurrent use.
+type DeliveryTracker struct {
+	mu    sync.Mutex
+	items map[string]sms.SMSSendResponseItem
+}
+
+// NewDeliveryTracker returns a new DeliveryTracker.
+func NewDeliveryTracker() *DeliveryTracker {
+	return &DeliveryTracker{
+		items: map[string]sms.SMSSendResponseItem{},
+	}
+}
+
+// Track tracks the successfully sent messages of the response.
+func (t *DeliveryTracker) Track(res *sms.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, item := range res.Value {
+		if item.Successful && item.MessageID != "" {
+			t.items[item.MessageID] = item
+		}
+	}
+}
+
+// Match returns the sent message of the delivery report.
+// Messages with a final delivery status are no longer tracked.
+func (t *DeliveryTracker) Match(report *MicrosoftCommunicationSMSDeliveryReportReceived) (sms.SMSSendResponseItem, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	item, ok := t.items[report.MessageID]
+	if !ok {
+		return item, false
+	}
+
+	if report.DeliveryStatus == DeliveryStatusDelivered || report.DeliveryStatus == DeliveryStatusFailed {
+		delete(t.items, report.MessageID)
+	}
+
+	return item, true
+}
+
+// Pending returns the sent messages without a final delivery report.
+func (t *DeliveryTracker) Pending() []sms.SMSSendResponseItem {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	items := make([]sms.SMSSendResponseItem, 0, len(t.items))
+	for _, item := range t.items {
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,67 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+	"github.com/zeiss/go-acs/sms"
+)
+
+func TestMicrosoftCommunicationSMSDeliveryReportReceived(t *testing.T) {
+	data := `{
+		"MessageId": "Outgoing_20200918002745d29ebbea-3341-4466-9690-0a03af35228e",
+		"From": "15555555555",
+		"To": "15555555555",
+		"DeliveryStatus": "Delivered",
+		"DeliveryStatusDetails": "No error.",
+		"ReceivedTimestamp": "2020-09-18T00:27:47.2420000Z",
+		"DeliveryAttempts": [
+			{
+				"Timestamp": "2020-09-18T00:27:47.2420000Z",
+				"SegmentsSucceeded": 1,
+				"SegmentsFailed": 0
+			}
+		]
+	}`
+
+	report := &events.MicrosoftCommunicationSMSDeliveryReportReceived{}
+	require.NoError(t, json.Unmarshal([]byte(data), report))
+	require.True(t, report.Delivered())
+	require.Len(t, report.DeliveryAttempts, 1)
+	require.Equal(t, 1, report.DeliveryAttempts[0].SegmentsSucceeded)
+	require.Equal(t, time.Date(2020, 9, 18, 0, 27, 47, 242000000, time.UTC), report.ReceivedTimestamp)
+
+	tracker := events.NewDeliveryTracker()
+	tracker.Track(&sms.Response{Value: []sms.SMSSendResponseItem{
+		{To: "+15555555555", MessageID: report.MessageID, Successful: true},
+		{To: "+15555555556", MessageID: "Outgoing_2", Successful: true},
+		{To: "+15555555557", Successful: false},
+	}})
+	require.Len(t, tracker.Pending(), 2)
+
+	item, ok := tracker.Match(report)
+	require.True(t, ok)
+	require.Equal(t, "+15555555555", item.To)
+	require.Len(t, tracker.Pending(), 1)
+
+	_, ok = tracker.Match(report)
+	require.False(t, ok)
+}
+
+func TestMicrosoftCommunicationSMSReceived(t *testing.T) {
+	data := `{
+		"MessageId": "Incoming_20200918002745d29ebbea-3341-4466-9690-0a03af35228e",
+		"From": "15555555555",
+		"To": "15555555555",
+		"Message": "Great to connect with ACS events",
+		"ReceivedTimestamp": "2020-09-18T00:27:45.32Z"
+	}`
+
+	e := &events.MicrosoftCommunicationSMSReceived{}
+	require.NoError(t, json.Unmarshal([]byte(data), e))
+	require.Equal(t, "Great to connect with ACS events", e.Message)
+	require.Equal(t, "15555555555", e.From)
+}
@@ -0,0 +1,109 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	// HeaderEventGridEventType is the header Event Grid sets to the type of the delivery.
+	HeaderEventGridEventType = "aeg-event-type"
+	// HeaderWebHookRequestOrigin is the header of the CloudEvents validation request with the origin of the sender.
+	HeaderWebHookRequestOrigin = "WebHook-Request-Origin"
+	// HeaderWebHookRequestRate is the header of the CloudEvents validation request with the requested rate.
+	HeaderWebHookRequestRate = "WebHook-Request-Rate"
+	// HeaderWebHookAllowedOrigin is the header of the CloudEvents validation response with the allowed origin.
+	HeaderWebHookAllowedOrigin = "WebHook-Allowed-Origin"
+	// HeaderWebHookAllowedRate is the header of the CloudEvents validation response with the allowed rate.
+	HeaderWebHookAllowedRate = "WebHook-Allowed-Rate"
+)
+
+const (
+	// EventGridEventTypeSubscriptionValidation is the aeg-event-type of a subscription validation.
+	EventGridEventTypeSubscriptionValidation = "SubscriptionValidation"
+	// EventTypeSubscriptionValidation is the type of the Microsoft.EventGrid.SubscriptionValidationEvent event.
+	EventTypeSubscriptionValidation = "Microsoft.EventGrid.SubscriptionValidationEvent"
+)
+
+// SubscriptionValidationEventData is the data of the Microsoft.EventGrid.SubscriptionValidationEvent event.
+type SubscriptionValidationEventData struct {
+	// ValidationCode is the code that has to be echoed to validate the subscription.
+	ValidationCode string `json:"validationCode"`
+	// ValidationURL is the URL to validate the subscription manually.
+	ValidationURL string `json:"validationUrl,omitempty"`
+}
+
+// SubscriptionValidationResponse is the response to the subscription validation.
+type SubscriptionValidationResponse struct {
+	// ValidationResponse is the validation code of the subscription validation.
+	ValidationResponse string `json:"validationResponse"`
+}
+
+// WithAllowedOrigins sets the origins that are allowed to deliver events,
+// like "eventgrid.azure.net". All origins are allowed if none are set.
+func WithAllowedOrigins(origins ...string) Opt {
+	return func(h *EventHandler) {
+		h.allowedOrigins = append(h.allowedOrigins, origins...)
+	}
+}
+
+// isOriginAllowed returns true if the origin is allowed to deliver events.
+func (h *EventHandler) isOriginAllowed(origin string) bool {
+	if len(h.allowedOrigins) == 0 {
+		return true
+	}
+
+	for _, o := range h.allowedOrigins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serveWebHookValidation answers the CloudEvents webhook abuse protection handshake.
+func (h *EventHandler) serveWebHookValidation(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get(HeaderWebHookRequestOrigin)
+	if origin == "" {
+		http.Error(w, "Missing WebHook-Request-Origin header", http.StatusBadRequest)
+		return
+	}
+
+	if !h.isOriginAllowed(origin) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Allow", "OPTIONS, POST")
+	w.Header().Set(HeaderWebHookAllowedOrigin, origin)
+	w.Header().Set(HeaderWebHookAllowedRate, "*")
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveSubscriptionValidation answers the Event Grid subscription validation handshake.
+func (h *EventHandler) serveSubscriptionValidation(w http.ResponseWriter, r *http.Request) {
+	var events []struct {
+		EventType string                          `json:"eventType"`
+		Data      SubscriptionValidationEventData `json:"data"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&events); err != nil {
+		http.Error(w, "Request body contains badly-formed JSON", http.StatusBadRequest)
+		return
+	}
+
+	for _, e := range events {
+		if e.EventType != EventTypeSubscriptionValidation || e.Data.ValidationCode == "" {
+			continue
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(SubscriptionValidationResponse{ValidationResponse: e.Data.ValidationCode})
+
+		return
+	}
+
+	http.Error(w, "Request body must contain a subscription validation event", http.StatusBadRequest)
+}
@@ -0,0 +1,71 @@
+package events_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/events"
+)
+
+func TestEventHandler_SubscriptionValidation(t *testing.T) {
+	body := `[{
+		"id": "2d1781af-3a4c-4d7c-bd0c-e34b19da4e66",
+		"topic": "/subscriptions/xx/resourceGroups/xx/providers/Microsoft.Communication/communicationServices/xx",
+		"subject": "",
+		"data": {
+			"validationCode": "512d38b6-c7b8-40c8-89fe-f46f9e9622b6",
+			"validationUrl": "https://rp-eastus2.eventgrid.azure.net:553/eventsubscriptions/myeventsub/validate?id=0000000000-0000-0000-0000-00000000000000&t=2022-10-28T04:23:35.1981776Z&apiVersion=2018-05-01-preview&token=1A1A1A1A"
+		},
+		"eventType": "Microsoft.EventGrid.SubscriptionValidationEvent",
+		"eventTime": "2022-10-28T04:23:35.1981776Z",
+		"metadataVersion": "1",
+		"dataVersion": "1"
+	}]`
+
+	req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	req.Header.Set(events.HeaderEventGridEventType, events.EventGridEventTypeSubscriptionValidation)
+
+	rr := httptest.NewRecorder()
+	events.NewEventHandler().ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	res := events.SubscriptionValidationResponse{}
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&res))
+	require.Equal(t, "512d38b6-c7b8-40c8-89fe-f46f9e9622b6", res.ValidationResponse)
+}
+
+func TestEventHandler_WebHookValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		code    int
+	}{
+		{name: "all origins", origin: "eventgrid.azure.net", code: http.StatusOK},
+		{name: "allowed origin", origins: []string{"eventgrid.azure.net"}, origin: "EventGrid.Azure.Net", code: http.StatusOK},
+		{name: "forbidden origin", origins: []string{"eventgrid.azure.net"}, origin: "example.com", code: http.StatusForbidden},
+		{name: "missing origin", code: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/events", nil)
+			if tt.origin != "" {
+				req.Header.Set(events.HeaderWebHookRequestOrigin, tt.origin)
+			}
+
+			rr := httptest.NewRecorder()
+			events.NewEventHandler(events.WithAllowedOrigins(tt.origins...)).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+
+			if tt.code == http.StatusOK {
+				require.Equal(t, tt.origin, rr.Header().Get(events.HeaderWebHookAllowedOrigin))
+				require.Equal(t, "*", rr.Header().Get(events.HeaderWebHookAllowedRate))
+			}
+		})
+	}
+}
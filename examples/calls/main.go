@@ -2,9 +2,7 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
 	"os"
@@ -12,15 +10,18 @@ import (
 
 	"github.com/zeiss/go-acs"
 	"github.com/zeiss/go-acs/calls"
-	internal "github.com/zeiss/go-acs/events"
+	"github.com/zeiss/go-acs/events"
+	"github.com/zeiss/go-acs/phonenumbers"
 
-	cloudevents "github.com/cloudevents/sdk-go/v2"
-	"github.com/zeiss/pkg/conv"
+	cloudevents "github.com/cloudevents/sdk-go"
 )
 
 var (
-	endpointURL string = ""
-	key         string = ""
+	endpointURL  string            = ""
+	key          string            = ""
+	callbackURL  string            = ""
+	sourceNumber phonenumbers.E164 = ""
+	targetNumber phonenumbers.E164 = ""
 )
 
 func main() {
@@ -34,96 +35,69 @@ func main() {
 
 	acsClient := acs.New(endpointURL, key, &client)
 
-	go func() {
-		http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
-			b, err := io.ReadAll(r.Body)
-			if err != nil {
-				fmt.Printf("Body reading error: %v", err)
-				return
+	handler := events.NewEventHandler(events.WithBufferSize(16))
+	defer handler.Close()
+
+	router := events.NewRouter(
+		events.WithConcurrency(4),
+		events.WithFallback(func(ctx context.Context, e cloudevents.Event) error {
+			fmt.Println(e)
+			return nil
+		}),
+	)
+
+	router.OnRecognizeCompleted(func(ctx context.Context, event *events.MicrosoftCommunicationRecognizeCompleted) error {
+		return acsClient.Call.CallHangUp(ctx, event.CallConnectionID)
+	})
+
+	router.OnParticipantsUpdated(func(ctx context.Context, event *events.MicrosoftCommunicationParticipantsUpdated) error {
+		for _, p := range event.Participants {
+			if p.Identifier.Kind != events.CommunicationIdentifierKindCommunicationUser {
+				continue
 			}
-			defer r.Body.Close()
 
-			var events []cloudevents.Event
-			err = json.Unmarshal(b, &events)
-			if err != nil {
-				log.Fatalln(err)
-				return
+			req := &calls.CallRecognizeRequest{
+				RecognizeInputType: calls.RecognizeInputTypeChoices,
+				PlayPrompt: calls.TextPrompt(
+					"Hello, the following incident occured: Instance-12345 on Azure is down. Please press 1 to acknowledge or 0 to decline.",
+					"en-US-AriaNeural",
+					"en-US",
+				),
+				RecognizeOptions: &calls.RecognizeOptions{
+					InterruptPrompt:                true,
+					InitialSilenceTimeoutInSeconds: 60,
+					Choices: []calls.Choice{
+						{
+							Label:   "Acknowledged",
+							Phrases: []string{"Acknowledge", "Ack", "Yes"},
+							Tone:    calls.ToneOne,
+						},
+						{
+							Label:   "Declined",
+							Phrases: []string{"Decline", "No"},
+							Tone:    calls.ToneZero,
+						},
+					},
+					TargetParticipant: calls.NewCommunicationUser(p.Identifier.CommunicationUser.ID),
+				},
 			}
 
-			fmt.Println(events)
-
-			for _, e := range events {
-				switch e.Type() {
-				case "Microsoft.Communication.RecognizeCompleted":
-					event := &internal.MicrosoftCommunicationCallConnected{}
-					err := e.DataAs(event)
-					if err != nil {
-						log.Fatalln(err)
-						continue
-					}
-
-					err = acsClient.Call.CallHangUp(ctx, event.CallConnectionID)
-					if err != nil {
-						log.Fatalf("Error hanging up call: %v", err)
-					}
-				case "Microsoft.Communication.ParticipantsUpdated":
-					event := &internal.MicrosoftCommunicationParticipantsUpdated{}
-					err := e.DataAs(event)
-					if err != nil {
-						log.Fatalln(err)
-						continue
-					}
-
-					for _, p := range event.Participants {
-						if p.Identifier.Kind != "communicationUser" {
-							continue
-						}
-
-						req := &calls.CallRecognizeRequest{
-							RecognizeInputType: calls.RecognizeInputTypeChoices,
-							PlayPrompt: calls.PlaySource{
-								Kind: calls.PlaySourceTypeText,
-								TextSource: &calls.TextSource{
-									Text:      "Hello, the following incident occured: Instance-12345 on Azure is down. Please press 1 to acknowledge or 0 to decline.",
-									VoiceName: "en-US-AriaNeural",
-								},
-							},
-							RecognizeOptions: &calls.RecognizeOptions{
-								InterruptPrompt:                true,
-								InitialSilenceTimeoutInSeconds: 60,
-								Choices: []calls.Choice{
-									{
-										Label:   "Acknowledged",
-										Phrases: []string{"Acknowledge", "Ack", "Yes"},
-										Tone:    calls.ToneOne,
-									},
-									{
-										Label:   "Declined",
-										Phrases: []string{"Decline", "No"},
-										Tone:    calls.ToneZero,
-									},
-								},
-								TargetParticipant: &calls.CommunicationIdentifier{
-									Kind: conv.String(p.Identifier.Kind),
-									CommunicationUser: &calls.CommunicationUser{
-										ID: p.Identifier.CommunicationUser.ID,
-									},
-								},
-							},
-							OperationCallbackUri: "",
-						}
-
-						err = acsClient.Call.CallMediaRecognize(ctx, event.CallConnectionID, req)
-						if err != nil {
-							panic(err)
-						}
-					}
-				case "Microsoft.Communication.CallConnected":
-
-				}
+			if err := acsClient.Call.CallMediaRecognize(ctx, event.CallConnectionID, req); err != nil {
+				return err
 			}
-		})
+		}
+
+		return nil
+	})
+
+	go func() {
+		if err := router.Run(ctx, handler.Events()); err != nil {
+			log.Print(err)
+		}
+	}()
 
+	go func() {
+		http.Handle("/callback", handler)
 		http.ListenAndServe(":8080", nil)
 	}()
 
@@ -134,22 +108,12 @@ func main() {
 	}()
 
 	req := &calls.CreateCallRequest{
-		SourceCallerIdNumber: &calls.PhonenumberIdentifier{
-			Value: "+",
-		},
+		SourceCallerIdNumber: calls.NewPhonenumberIdentifier(sourceNumber),
 		CallIntelligenceOptions: &calls.CallIntelligenceOptions{
 			CognitiveServicesEndpoint: "",
 		},
-		Targets: []calls.CommunicationIdentifier{
-			{
-				Kind: "phonenumber",
-				PhoneNumber: &calls.PhonenumberIdentifier{
-					ID:    "+",
-					Value: "+",
-				},
-			},
-		},
-		CallbackUri: "",
+		Targets:     []calls.CommunicationIdentifier{*calls.NewPhoneNumber(targetNumber)},
+		CallbackUri: callbackURL,
 	}
 
 	res, err := acsClient.Call.CreateCall(ctx, req)
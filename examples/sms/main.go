@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/phonenumbers"
 	"github.com/zeiss/go-acs/sms"
 )
 
@@ -23,11 +24,9 @@ func main() {
 	acsClient := acs.New(endpointURL, key, &client)
 
 	res, err := acsClient.SMS.SendSMS(ctx, &sms.Request{
-		From: "+1234567890",
+		From: "+14255550100",
 		SMSRecipients: []sms.SMSRecipients{
-			{
-				To: "+10987654321",
-			},
+			sms.NewRecipient(phonenumbers.MustParse("(425) 555-0123", "US")),
 		},
 		Message: "Thanks for using our service!",
 		SMSSendOptions: sms.SMSSendOptions{
@@ -2,14 +2,36 @@ package identities
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/zeiss/carry"
+	"github.com/zeiss/go-acs/internal/httpx"
 )
 
+// DefaultVersion is the default API version of the identity service.
+const DefaultVersion = "2023-10-01"
+
+// Version is the API version query of the identity service.
+type Version struct {
+	APIVersion string `url:"api-version"`
+}
+
 // Service is the service for identity.
 type Service struct {
-	client *carry.Client
+	client  *carry.Client
+	doer    carry.Doer
+	version *Version
+}
+
+// Opt is the option for the identity service.
+type Opt func(*Service)
+
+// WithAPIVersion sets the API version of the identity service.
+func WithAPIVersion(version string) Opt {
+	return func(s *Service) {
+		s.version = &Version{APIVersion: version}
+	}
 }
 
 // CreateIdentityRequestBody is the request body for creating an identity.
@@ -38,9 +60,28 @@ const CommunicationIdentityTokenScopeVoip CommunicationIdentityTokenScope = "voi
 // CommunicationIdentityTokenScopeVoipJoin is the voip join scope.
 const CommunicationIdentityTokenScopeVoipJoin CommunicationIdentityTokenScope = "voip.join"
 
-// NewService returns a new SmsService
-func NewService(c *carry.Client) *Service {
-	return &Service{c}
+// WithDoer sets the doer that sends the requests of the identity service.
+// The client passed to NewService only builds the requests, and its doer is not used.
+// By default the requests are sent with the http.DefaultClient.
+func WithDoer(doer carry.Doer) Opt {
+	return func(s *Service) {
+		s.doer = doer
+	}
+}
+
+// NewService returns a new IdentityService
+func NewService(c *carry.Client, opts ...Opt) *Service {
+	s := &Service{
+		client:  c,
+		doer:    http.DefaultClient,
+		version: &Version{APIVersion: DefaultVersion},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // CommunicationIdentityAccessToken is the access token of the identity.
@@ -64,7 +105,12 @@ type CommunicationIdentityAccessTokenResult struct {
 func (s *Service) CreateIdentity(ctx context.Context, body *CreateIdentityRequestBody) (*CommunicationIdentityAccessTokenResult, error) {
 	res := &CommunicationIdentityAccessTokenResult{}
 
-	_, err := s.client.New().Post("/identities").ReceiveSuccess(ctx, res)
+	req := s.client.New().Post("/identities").QueryStruct(s.version)
+	if body != nil {
+		req = req.BodyJSON(body)
+	}
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
 	if err != nil {
 		return nil, err
 	}
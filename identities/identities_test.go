@@ -0,0 +1,57 @@
+package identities_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/identities"
+)
+
+func TestService_CreateIdentity(t *testing.T) {
+	bodies := make(chan []byte, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/identities" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body := json.RawMessage{}
+		if r.ContentLength > 0 {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		bodies <- body
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(identities.CommunicationIdentityAccessTokenResult{
+			Identity: identities.CommunicationIdentity{ID: "8:acs:resource_user"},
+		})
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	res, err := client.Identity.CreateIdentity(t.Context(), &identities.CreateIdentityRequestBody{
+		CreateTokenWithScopes: []identities.CommunicationIdentityTokenScope{identities.CommunicationIdentityTokenScopeVoip},
+		ExpiresInMinutes:      60,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "8:acs:resource_user", res.Identity.ID)
+
+	body := identities.CreateIdentityRequestBody{}
+	require.NoError(t, json.Unmarshal(<-bodies, &body))
+	require.Equal(t, []identities.CommunicationIdentityTokenScope{identities.CommunicationIdentityTokenScopeVoip}, body.CreateTokenWithScopes)
+	require.Equal(t, 60, body.ExpiresInMinutes)
+
+	_, err = client.Identity.CreateIdentity(t.Context(), nil)
+	require.NoError(t, err)
+	require.Empty(t, <-bodies)
+}
@@ -0,0 +1,78 @@
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zeiss/carry"
+)
+
+// StatusError is the error for a response with a status other than 2xx.
+type StatusError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is the HTTP status of the response, like "404 Not Found".
+	Status string
+}
+
+// Error returns the error message.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("httpx: unexpected status %s", e.Status)
+}
+
+// HTTPStatusCode returns the HTTP status code of the response.
+func (e *StatusError) HTTPStatusCode() int {
+	return e.StatusCode
+}
+
+// Receive builds the request of the client, sends it with the doer and decodes
+// a successful response into successV. A response with a status other than 2xx is an error.
+//
+// The carry client only builds the request. Its Doer and Client setters do not
+// take effect in carry v1.0.0, so it would always send with the http.DefaultClient.
+func Receive(ctx context.Context, doer carry.Doer, c *carry.Client, successV any) (*http.Response, error) {
+	res, err := Send(ctx, doer, c)
+	if err != nil {
+		return res, err
+	}
+	defer res.Body.Close()
+	defer func() { _, _ = io.Copy(io.Discard, res.Body) }()
+
+	if res.StatusCode == http.StatusNoContent || res.ContentLength == 0 || successV == nil {
+		return res, nil
+	}
+
+	err = json.NewDecoder(res.Body).Decode(successV)
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// Send builds the request of the client and sends it with the doer.
+// A response with a status other than 2xx is an error and its body is closed.
+// Otherwise the caller has to close the body of the response.
+func Send(ctx context.Context, doer carry.Doer, c *carry.Client) (*http.Response, error) {
+	req, err := c.Request(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := doer.Do(req)
+	if err != nil {
+		return res, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		return res, &StatusError{StatusCode: res.StatusCode, Status: res.Status}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package httpx_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/carry"
+	"github.com/zeiss/go-acs/internal/httpx"
+)
+
+func TestReceive(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound"}}`))
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer srv.Close()
+
+	client := carry.New().Base(srv.URL)
+
+	v := struct {
+		ID string `json:"id"`
+	}{}
+
+	_, err := httpx.Receive(t.Context(), srv.Client(), client.New().Get("/found"), &v)
+	require.NoError(t, err)
+	require.Equal(t, "1", v.ID)
+
+	v.ID = ""
+
+	res, err := httpx.Receive(t.Context(), srv.Client(), client.New().Get("/missing"), &v)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+	require.Empty(t, v.ID)
+
+	var statusErr *httpx.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	require.Equal(t, http.StatusNotFound, statusErr.HTTPStatusCode())
+}
@@ -0,0 +1,85 @@
+// Package repeatability makes non-idempotent requests safe to resend.
+package repeatability
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeiss/carry"
+)
+
+const (
+	// HeaderRequestID is the header for the unique id of a repeatable request.
+	HeaderRequestID = "Repeatability-Request-ID"
+	// HeaderFirstSent is the header for the time a repeatable request was first sent.
+	HeaderFirstSent = "Repeatability-First-Sent"
+)
+
+// Repeatability are the values that make a non-idempotent request safe to resend.
+// ACS processes a request with the same values only once.
+type Repeatability struct {
+	// RequestID is the unique id of the request.
+	RequestID string
+	// FirstSent is the time the request was first sent.
+	FirstSent time.Time
+}
+
+// New returns new repeatability values for a request sent now.
+func New() Repeatability {
+	return Repeatability{
+		RequestID: uuid.NewString(),
+		FirstSent: time.Now().UTC(),
+	}
+}
+
+// Derive returns the values of the n-th of several requests that are made with the same values.
+// The first request keeps the values, the others get a request id derived from them,
+// so that resending all requests with the same values gives each the same id again.
+func (r Repeatability) Derive(n int) Repeatability {
+	if n == 0 {
+		return r
+	}
+
+	r.RequestID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(r.RequestID+"/"+strconv.Itoa(n))).String()
+
+	return r
+}
+
+// DeriveKey returns the values of a part of the request that is identified by the key,
+// like a recipient of an SMS. The request id is derived from the values and the key,
+// so that resending the request with the same values gives the part the same id again.
+func (r Repeatability) DeriveKey(key string) Repeatability {
+	r.RequestID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(r.RequestID+"#"+key)).String()
+
+	return r
+}
+
+type key struct{}
+
+// WithContext returns a context that carries the values.
+func WithContext(ctx context.Context, r Repeatability) context.Context {
+	return context.WithValue(ctx, key{}, r)
+}
+
+// FromContext returns the values of the context.
+func FromContext(ctx context.Context) (Repeatability, bool) {
+	r, ok := ctx.Value(key{}).(Repeatability)
+	return r, ok && r.RequestID != ""
+}
+
+// Set sets the repeatability headers on the request, with the values of the context or new ones.
+func Set(ctx context.Context, c *carry.Client) *carry.Client {
+	r, ok := FromContext(ctx)
+	if !ok {
+		r = New()
+	}
+
+	if r.FirstSent.IsZero() {
+		r.FirstSent = time.Now().UTC()
+	}
+
+	return c.Set(HeaderRequestID, r.RequestID).Set(HeaderFirstSent, r.FirstSent.UTC().Format(http.TimeFormat))
+}
@@ -0,0 +1,257 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxLength is the maximum number of digits of an E.164 number, including the country calling code.
+	MaxLength = 15
+	// MinNationalLength is the minimum number of digits of a national number.
+	MinNationalLength = 4
+	// nanpNationalLength is the number of digits of a national number in the North American Numbering Plan.
+	nanpNationalLength = 10
+)
+
+var (
+	// ErrEmpty is returned when the number is empty.
+	ErrEmpty = errors.New("phonenumbers: number is empty")
+	// ErrInvalidCharacters is returned when the number contains characters other than digits and formatting.
+	ErrInvalidCharacters = errors.New("phonenumbers: number contains invalid characters")
+	// ErrMissingRegion is returned when a national number is parsed without a default region.
+	ErrMissingRegion = errors.New("phonenumbers: national number requires a default region")
+	// ErrUnknownRegion is returned when the default region is not known.
+	ErrUnknownRegion = errors.New("phonenumbers: unknown region")
+	// ErrInvalidCountryCode is returned when the number does not start with a known country calling code.
+	ErrInvalidCountryCode = errors.New("phonenumbers: invalid country calling code")
+	// ErrTooShort is returned when the number is too short.
+	ErrTooShort = errors.New("phonenumbers: number is too short")
+	// ErrTooLong is returned when the number is too long.
+	ErrTooLong = errors.New("phonenumbers: number is too long")
+	// ErrNotNormalized is returned when the number is not in E.164 format, like "+1 (425) 555-0123".
+	ErrNotNormalized = errors.New("phonenumbers: number is not in E.164 format")
+)
+
+// ParseError is the error for a number that can not be parsed.
+type ParseError struct {
+	// Number is the number that was parsed.
+	Number string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s: %q", e.Err.Error(), e.Number)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// E164 is a phone number in E.164 format, like "+14255550123".
+type E164 string
+
+// Parse parses a phone number. Numbers in international format, starting with
+// "+" or the "00" international prefix, are parsed as is. National numbers are
+// parsed for the default region, an ISO 3166-1 alpha-2 code like "US" or "DE".
+// Spaces, dashes, dots, slashes and parentheses are ignored.
+func Parse(number, defaultRegion string) (E164, error) {
+	e, err := parse(number, defaultRegion)
+	if err != nil {
+		return "", &ParseError{Number: number, Err: err}
+	}
+
+	return e, nil
+}
+
+// MustParse is like Parse, but panics if the number can not be parsed.
+func MustParse(number, defaultRegion string) E164 {
+	e, err := Parse(number, defaultRegion)
+	if err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
+// Normalize parses a phone number and returns it in E.164 format.
+func Normalize(number, defaultRegion string) (string, error) {
+	e, err := Parse(number, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+
+	return e.String(), nil
+}
+
+// IsValid returns true if the number is a valid phone number in international format.
+func IsValid(number string) bool {
+	_, err := Parse(number, "")
+	return err == nil
+}
+
+// CountryCallingCodeForRegion returns the country calling code of a region.
+func CountryCallingCodeForRegion(region string) (int, bool) {
+	code, ok := callingCodes[strings.ToUpper(region)]
+	return code, ok
+}
+
+// String returns the number in E.164 format.
+func (e E164) String() string {
+	return string(e)
+}
+
+// Validate returns an error if the number is not a valid E.164 number.
+// The number has to be normalized, formatting like spaces or dashes is an error.
+func (e E164) Validate() error {
+	n, err := Parse(string(e), "")
+	if err != nil {
+		return err
+	}
+
+	if n != e {
+		return &ParseError{Number: string(e), Err: ErrNotNormalized}
+	}
+
+	return nil
+}
+
+// CountryCallingCode returns the country calling code of the number, like 49 for "+4930123456".
+func (e E164) CountryCallingCode() int {
+	code, _ := splitCallingCode(strings.TrimPrefix(string(e), "+"))
+	return code
+}
+
+// NationalNumber returns the number without the country calling code.
+func (e E164) NationalNumber() string {
+	_, national := splitCallingCode(strings.TrimPrefix(string(e), "+"))
+	return national
+}
+
+// Region returns the main region of the country calling code of the number,
+// like "US" for "+14255550123", or "001" for non-geographic numbers.
+func (e E164) Region() string {
+	return regions[e.CountryCallingCode()]
+}
+
+func parse(number, defaultRegion string) (E164, error) {
+	digits, international, err := clean(number)
+	if err != nil {
+		return "", err
+	}
+
+	if digits == "" {
+		return "", ErrEmpty
+	}
+
+	if !international {
+		if defaultRegion == "" {
+			return "", ErrMissingRegion
+		}
+
+		code, ok := CountryCallingCodeForRegion(defaultRegion)
+		if !ok {
+			return "", ErrUnknownRegion
+		}
+
+		switch {
+		case strings.HasPrefix(digits, "00"):
+			digits, international = digits[2:], true
+		case code == 1 && strings.HasPrefix(digits, "011"):
+			digits, international = digits[3:], true
+		default:
+			digits = strconv.Itoa(code) + trimTrunkPrefix(code, digits)
+		}
+	}
+
+	code, national := splitCallingCode(digits)
+	if code == 0 {
+		return "", ErrInvalidCountryCode
+	}
+
+	if err := validateLength(code, national); err != nil {
+		return "", err
+	}
+
+	return E164("+" + digits), nil
+}
+
+// clean removes the formatting of a number and reports whether it is in international format.
+func clean(number string) (string, bool, error) {
+	number = strings.TrimSpace(number)
+
+	international := strings.HasPrefix(number, "+")
+	number = strings.TrimPrefix(number, "+")
+
+	var b strings.Builder
+
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune(" -./()\u00a0", r):
+		default:
+			return "", false, ErrInvalidCharacters
+		}
+	}
+
+	return b.String(), international, nil
+}
+
+// trimTrunkPrefix removes the national trunk prefix of a national number.
+func trimTrunkPrefix(code int, national string) string {
+	prefix, ok := trunkPrefixes[code]
+	if !ok {
+		prefix = "0"
+	}
+
+	if prefix == "" {
+		return national
+	}
+
+	if code == 1 && len(national) != nanpNationalLength+1 {
+		return national
+	}
+
+	return strings.TrimPrefix(national, prefix)
+}
+
+// splitCallingCode splits the digits of an international number into
+// the country calling code and the national number.
+// Calling codes are prefix-free, so the shortest match is the only match.
+func splitCallingCode(digits string) (int, string) {
+	for i := 1; i <= 3 && i <= len(digits); i++ {
+		code, err := strconv.Atoi(digits[:i])
+		if err != nil {
+			return 0, ""
+		}
+
+		if _, ok := regions[code]; ok {
+			return code, digits[i:]
+		}
+	}
+
+	return 0, ""
+}
+
+func validateLength(code int, national string) error {
+	total := len(strconv.Itoa(code)) + len(national)
+
+	switch {
+	case total > MaxLength:
+		return ErrTooLong
+	case code == 1 && len(national) < nanpNationalLength:
+		return ErrTooShort
+	case code == 1 && len(national) > nanpNationalLength:
+		return ErrTooLong
+	case len(national) < MinNationalLength:
+		return ErrTooShort
+	}
+
+	return nil
+}
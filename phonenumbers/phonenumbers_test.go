@@ -0,0 +1,87 @@
+package phonenumbers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/phonenumbers"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		region string
+		want   phonenumbers.E164
+		err    error
+	}{
+		{name: "e164", number: "+14255550123", want: "+14255550123"},
+		{name: "formatted international", number: "+1 (425) 555-0123", want: "+14255550123"},
+		{name: "international prefix", number: "0049 30 1234567", region: "DE", want: "+49301234567"},
+		{name: "nanp international prefix", number: "011 44 20 7946 0958", region: "US", want: "+442079460958"},
+		{name: "national us", number: "(425) 555-0123", region: "US", want: "+14255550123"},
+		{name: "national us with trunk", number: "1-425-555-0123", region: "us", want: "+14255550123"},
+		{name: "national de", number: "030 1234567", region: "DE", want: "+49301234567"},
+		{name: "national gb", number: "020 7946 0958", region: "GB", want: "+442079460958"},
+		{name: "national it keeps zero", number: "06 1234 5678", region: "IT", want: "+390612345678"},
+		{name: "empty", number: " ", err: phonenumbers.ErrEmpty},
+		{name: "letters", number: "+1 425 CALL NOW", err: phonenumbers.ErrInvalidCharacters},
+		{name: "national without region", number: "4255550123", err: phonenumbers.ErrMissingRegion},
+		{name: "unknown region", number: "4255550123", region: "XX", err: phonenumbers.ErrUnknownRegion},
+		{name: "invalid country code", number: "+999 1234567", err: phonenumbers.ErrInvalidCountryCode},
+		{name: "nanp too short", number: "+1425555012", err: phonenumbers.ErrTooShort},
+		{name: "too short", number: "+49 123", err: phonenumbers.ErrTooShort},
+		{name: "too long", number: "+49 1234 5678 9012 34", err: phonenumbers.ErrTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := phonenumbers.Parse(tt.number, tt.region)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				var parseErr *phonenumbers.ParseError
+				require.ErrorAs(t, err, &parseErr)
+				require.Equal(t, tt.number, parseErr.Number)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.NoError(t, got.Validate())
+		})
+	}
+}
+
+func TestE164(t *testing.T) {
+	e := phonenumbers.MustParse("+49 30 1234567", "")
+	require.Equal(t, 49, e.CountryCallingCode())
+	require.Equal(t, "301234567", e.NationalNumber())
+	require.Equal(t, "DE", e.Region())
+
+	e = phonenumbers.MustParse("+1 425 555 0123", "")
+	require.Equal(t, 1, e.CountryCallingCode())
+	require.Equal(t, "US", e.Region())
+
+	e = phonenumbers.MustParse("+353 1 234 5678", "")
+	require.Equal(t, 353, e.CountryCallingCode())
+	require.Equal(t, "IE", e.Region())
+
+	require.Error(t, phonenumbers.E164("4255550123").Validate())
+	require.ErrorIs(t, phonenumbers.E164("+1 (425) 555-0123").Validate(), phonenumbers.ErrNotNormalized)
+	require.ErrorIs(t, phonenumbers.E164("+1-425-555-0123").Validate(), phonenumbers.ErrNotNormalized)
+}
+
+func TestNormalize(t *testing.T) {
+	n, err := phonenumbers.Normalize("0171 1234567", "DE")
+	require.NoError(t, err)
+	require.Equal(t, "+491711234567", n)
+
+	require.True(t, phonenumbers.IsValid("+491711234567"))
+	require.False(t, phonenumbers.IsValid("01711234567"))
+
+	code, ok := phonenumbers.CountryCallingCodeForRegion("ch")
+	require.True(t, ok)
+	require.Equal(t, 41, code)
+}
@@ -0,0 +1,36 @@
+package acs
+
+import (
+	"context"
+
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+// HeaderRepeatabilityFirstSent is the header for the time a repeatable request was first sent.
+const HeaderRepeatabilityFirstSent = repeatability.HeaderFirstSent
+
+// Repeatability are the values that make a non-idempotent request safe to resend.
+// ACS processes a request with the same values only once.
+//
+// Only the endpoints that honour repeatability send them: sending SMS and the call automation
+// actions, like creating, answering or transferring a call and playing media.
+type Repeatability = repeatability.Repeatability
+
+// NewRepeatability returns new repeatability values for a request sent now.
+func NewRepeatability() Repeatability {
+	return repeatability.New()
+}
+
+// ContextWithRepeatability returns a context that sends the given repeatability values
+// instead of generated ones. Use it to resend a request that was sent before,
+// and use a new context for every distinct request.
+// SMS that are sent in several batches derive a distinct request id for each batch,
+// and the repeatability of each recipient is derived from the values and its phone number.
+func ContextWithRepeatability(ctx context.Context, r Repeatability) context.Context {
+	return repeatability.WithContext(ctx, r)
+}
+
+// RepeatabilityFromContext returns the repeatability values of the context.
+func RepeatabilityFromContext(ctx context.Context) (Repeatability, bool) {
+	return repeatability.FromContext(ctx)
+}
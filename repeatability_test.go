@@ -0,0 +1,148 @@
+package acs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/sms"
+)
+
+// newRecordingServer returns a server that records the request headers
+// and fails the first n requests with 503.
+func newRecordingServer(t *testing.T, n int) (*httptest.Server, func() []http.Header) {
+	var mu sync.Mutex
+	var headers []http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Clone())
+		attempt := len(headers)
+		mu.Unlock()
+
+		if attempt <= n {
+			w.Header().Set("retry-after-ms", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []http.Header {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return headers
+	}
+}
+
+func TestRepeatability_Generated(t *testing.T) {
+	srv, headers := newRecordingServer(t, 2)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	_, err := client.Call.CreateCall(context.Background(), newCreateCallRequest())
+	require.NoError(t, err)
+
+	hh := headers()
+	require.Len(t, hh, 3)
+
+	id := hh[0].Get(acs.HeaderRepeatabilityRequestID)
+	firstSent := hh[0].Get(acs.HeaderRepeatabilityFirstSent)
+	require.NotEmpty(t, id)
+	require.NotEmpty(t, firstSent)
+
+	_, err = http.ParseTime(firstSent)
+	require.NoError(t, err)
+
+	for _, h := range hh[1:] {
+		require.Equal(t, id, h.Get(acs.HeaderRepeatabilityRequestID))
+		require.Equal(t, firstSent, h.Get(acs.HeaderRepeatabilityFirstSent))
+	}
+
+	_, err = client.Call.CreateCall(context.Background(), newCreateCallRequest())
+	require.NoError(t, err)
+	require.NotEqual(t, id, headers()[3].Get(acs.HeaderRepeatabilityRequestID))
+}
+
+func TestRepeatability_NotRepeatable(t *testing.T) {
+	srv, headers := newRecordingServer(t, 1)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	_, err := client.Identity.CreateIdentity(context.Background(), nil)
+	require.Error(t, err)
+
+	hh := headers()
+	require.Len(t, hh, 1)
+	require.Empty(t, hh[0].Get(acs.HeaderRepeatabilityRequestID))
+}
+
+func TestRepeatability_SMSBatches(t *testing.T) {
+	srv, headers := newRecordingServer(t, 0)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithSMSOptions(sms.WithBatchSize(1)))
+
+	r := acs.NewRepeatability()
+
+	req := &sms.Request{
+		From:          "+14255550100",
+		Message:       "Hello",
+		SMSRecipients: []sms.SMSRecipients{{To: "+14255550101"}, {To: "+14255550102"}, {To: "+14255550103"}},
+	}
+
+	_, err := client.SMS.SendSMS(acs.ContextWithRepeatability(context.Background(), r), req)
+	require.NoError(t, err)
+
+	ids := map[string]bool{}
+	for _, h := range headers() {
+		ids[h.Get(acs.HeaderRepeatabilityRequestID)] = true
+	}
+
+	require.Len(t, ids, 3)
+	require.True(t, ids[r.RequestID])
+
+	// Resending with the same values sends the same ids again.
+	_, err = client.SMS.SendSMS(acs.ContextWithRepeatability(context.Background(), r), req)
+	require.NoError(t, err)
+
+	for _, h := range headers()[3:] {
+		require.True(t, ids[h.Get(acs.HeaderRepeatabilityRequestID)])
+	}
+}
+
+func TestRepeatability_FromContext(t *testing.T) {
+	srv, headers := newRecordingServer(t, 0)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	r := acs.Repeatability{
+		RequestID: "3c4f1e5a-5b0b-4a8c-9a40-4b9f1a0e2f11",
+		FirstSent: time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC),
+	}
+
+	_, err := client.Call.CreateCall(acs.ContextWithRepeatability(context.Background(), r), newCreateCallRequest())
+	require.NoError(t, err)
+
+	hh := headers()
+	require.Equal(t, r.RequestID, hh[0].Get(acs.HeaderRepeatabilityRequestID))
+	require.Equal(t, "Sat, 15 Jun 2024 10:00:00 GMT", hh[0].Get(acs.HeaderRepeatabilityFirstSent))
+}
+
+func TestRepeatability_Idempotent(t *testing.T) {
+	srv, headers := newRecordingServer(t, 0)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.NoError(t, err)
+	require.Empty(t, headers()[0].Get(acs.HeaderRepeatabilityRequestID))
+}
@@ -0,0 +1,153 @@
+package acs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/zeiss/go-acs/internal/repeatability"
+)
+
+const (
+	// HeaderRetryAfter is the standard header for the time to wait before retrying.
+	HeaderRetryAfter = "Retry-After"
+	// HeaderRetryAfterMs is the header for the milliseconds to wait before retrying.
+	HeaderRetryAfterMs = "retry-after-ms"
+	// HeaderXMsRetryAfterMs is the header for the milliseconds to wait before retrying.
+	HeaderXMsRetryAfterMs = "x-ms-retry-after-ms"
+	// HeaderRepeatabilityRequestID is the header that makes a request safe to resend.
+	HeaderRepeatabilityRequestID = repeatability.HeaderRequestID
+)
+
+// RetryPolicy is the policy for retrying failed requests.
+//
+// Only idempotent requests and requests that carry a Repeatability-Request-ID
+// header are retried, and only after a network error or a response with one of
+// the status codes. Delays sent by the service with the Retry-After,
+// retry-after-ms or x-ms-retry-after-ms headers take precedence over the backoff.
+// A delay longer than MaxDelay ends the retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. It doubles with each retry.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay between two attempts.
+	// Zero means no limit.
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay that is randomized, between 0 and 1.
+	Jitter float64
+	// StatusCodes are the response status codes that are retried.
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy is the default retry policy of the client.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   800 * time.Millisecond,
+	MaxDelay:    60 * time.Second,
+	Jitter:      0.2,
+	StatusCodes: []int{
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// NoRetryPolicy is a retry policy that never retries.
+var NoRetryPolicy = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy sets the retry policy of the client.
+func WithRetryPolicy(policy RetryPolicy) Opt {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// shouldRetry returns true if the result of an attempt is worth another attempt.
+// Errors before the request is sent, like signing errors, and canceled requests are not retried.
+func (p RetryPolicy) shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return isNetworkError(err)
+	}
+
+	return slices.Contains(p.StatusCodes, res.StatusCode)
+}
+
+// isNetworkError returns true if the error happened while sending the request or receiving the response.
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// delay returns the time to wait before the given retry, starting with 1.
+// It returns false if the service asked for a delay longer than MaxDelay.
+func (p RetryPolicy) delay(retry int, res *http.Response) (time.Duration, bool) {
+	if d, ok := retryAfter(res); ok {
+		return d, p.MaxDelay <= 0 || d <= p.MaxDelay
+	}
+
+	d := float64(p.BaseDelay) * math.Pow(2, float64(retry-1))
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d), true
+}
+
+// retryAfter returns the delay the service asked for, if any.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	for _, h := range []string{HeaderRetryAfterMs, HeaderXMsRetryAfterMs} {
+		if ms, err := strconv.ParseInt(res.Header.Get(h), 10, 64); err == nil && ms >= 0 {
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+
+	v := res.Header.Get(HeaderRetryAfter)
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.ParseInt(v, 10, 64); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// isRetryable returns true if the request can safely be sent again.
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return req.Header.Get(HeaderRepeatabilityRequestID) != ""
+}
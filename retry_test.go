@@ -0,0 +1,159 @@
+package acs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+)
+
+// newFlakyServer returns a server that fails the first n requests with the given status.
+func newFlakyServer(t *testing.T, n int32, status int, header http.Header) (*httptest.Server, *int32) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= n {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &attempts
+}
+
+var testRetryPolicy = acs.RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    10 * time.Millisecond,
+	Jitter:      0.5,
+	StatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+}
+
+func TestRetry_RetryAfterMs(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 2, http.StatusServiceUnavailable, http.Header{"Retry-After-Ms": {"5"}})
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, http.StatusTooManyRequests, nil)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.True(t, acs.IsThrottled(err))
+	require.EqualValues(t, 3, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_NotRetryableStatus(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, http.StatusBadRequest, nil)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.Error(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_Repeatable(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	_, err := client.Call.CreateCall(context.Background(), newCreateCallRequest())
+	require.NoError(t, err)
+	require.EqualValues(t, 3, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_ContextCanceled(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, http.StatusServiceUnavailable, http.Header{"Retry-After": {"30"}})
+
+	policy := testRetryPolicy
+	policy.MaxDelay = time.Minute
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Call.CallHangUp(ctx, "test")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.EqualValues(t, 1, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_RetryAfterExceedsMaxDelay(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, http.StatusTooManyRequests, http.Header{"Retry-After": {"3600"}})
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	start := time.Now()
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.True(t, acs.IsThrottled(err))
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.EqualValues(t, 1, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_SignerError(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 0, http.StatusOK, nil)
+
+	var tokens int32
+
+	credential := acs.TokenCredentialFunc(func(ctx context.Context, scopes ...string) (acs.AccessToken, error) {
+		atomic.AddInt32(&tokens, 1)
+		return acs.AccessToken{}, nil
+	})
+
+	client := acs.NewWithTokenCredential(srv.URL, credential, srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.ErrorIs(t, err, acs.ErrEmptyToken)
+	require.EqualValues(t, 1, atomic.LoadInt32(&tokens))
+	require.EqualValues(t, 0, atomic.LoadInt32(attempts))
+}
+
+func TestRetry_NetworkError(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			panic(http.ErrAbortHandler)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(testRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.NoError(t, err)
+	require.EqualValues(t, 2, atomic.LoadInt32(&attempts))
+}
+
+func TestRetry_Disabled(t *testing.T) {
+	srv, attempts := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithRetryPolicy(acs.NoRetryPolicy))
+
+	err := client.Call.CallHangUp(context.Background(), "test")
+	require.Error(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt32(attempts))
+}
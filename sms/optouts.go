@@ -0,0 +1,143 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/zeiss/go-acs/internal/httpx"
+)
+
+// DefaultOptOutVersion is the default API version of the opt-out endpoints.
+const DefaultOptOutVersion = "2024-12-10-preview"
+
+// OptOutRequest is the request for managing opt-outs.
+type OptOutRequest struct {
+	// From is the sender phone number the recipients opted out of, formatted as E.164.
+	From string `json:"from"`
+	// Recipients are the recipients to manage.
+	Recipients []OptOutRecipient `json:"recipients"`
+}
+
+// OptOutRecipient is a recipient of an opt-out request.
+type OptOutRecipient struct {
+	// To is the phone number of the recipient.
+	To string `json:"to"`
+}
+
+// NewOptOutRequest returns a new opt-out request for the sender and recipients.
+func NewOptOutRequest(from string, to ...string) *OptOutRequest {
+	r := &OptOutRequest{From: from}
+
+	for _, t := range to {
+		r.Recipients = append(r.Recipients, OptOutRecipient{To: t})
+	}
+
+	return r
+}
+
+// OptOutResponse is the response for managing opt-outs.
+type OptOutResponse struct {
+	Value []OptOutResponseItem `json:"value"`
+}
+
+// OptOutResponseItem is the result for a single recipient.
+type OptOutResponseItem struct {
+	// To is the phone number of the recipient.
+	To string `json:"to"`
+	// HttpStatusCode is the status code of the recipient.
+	HttpStatusCode int `json:"httpStatusCode"`
+	// IsOptedOut is whether the recipient opted out. It is only set by CheckOptOuts.
+	IsOptedOut bool `json:"isOptedOut,omitempty"`
+	// ErrorMessage is the error message of the recipient.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// Successful returns true if the recipient was processed.
+func (i OptOutResponseItem) Successful() bool {
+	return i.HttpStatusCode >= 200 && i.HttpStatusCode <= 299
+}
+
+// AddOptOuts opts the recipients out of messages from the sender.
+func (s *Service) AddOptOuts(ctx context.Context, request *OptOutRequest) (*OptOutResponse, error) {
+	return s.optOuts(ctx, "add", request)
+}
+
+// RemoveOptOuts opts the recipients back in to messages from the sender.
+func (s *Service) RemoveOptOuts(ctx context.Context, request *OptOutRequest) (*OptOutResponse, error) {
+	return s.optOuts(ctx, "remove", request)
+}
+
+// CheckOptOuts checks whether the recipients opted out of messages from the sender.
+func (s *Service) CheckOptOuts(ctx context.Context, request *OptOutRequest) (*OptOutResponse, error) {
+	return s.optOuts(ctx, "check", request)
+}
+
+func (s *Service) optOuts(ctx context.Context, action string, request *OptOutRequest) (*OptOutResponse, error) {
+	res := &OptOutResponse{}
+
+	req := s.client.New().Post("/sms/optouts:" + action).QueryStruct(s.optOutVersion).BodyJSON(request)
+
+	_, err := httpx.Receive(ctx, s.doer, req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// filterOptOuts removes the recipients that opted out from the request.
+// Recipients that could not be checked are not sent to and returned as failed items.
+func (s *Service) filterOptOuts(ctx context.Context, request *Request) (*Request, []SMSSendResponseItem, []string, error) {
+	checked := map[string]OptOutResponseItem{}
+
+	for start := 0; start < len(request.SMSRecipients); start += MaxRecipientsPerRequest {
+		check := NewOptOutRequest(request.From)
+		for _, r := range request.SMSRecipients[start:min(start+MaxRecipientsPerRequest, len(request.SMSRecipients))] {
+			check.Recipients = append(check.Recipients, OptOutRecipient{To: r.To})
+		}
+
+		res, err := s.CheckOptOuts(ctx, check)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, item := range res.Value {
+			checked[item.To] = item
+		}
+	}
+
+	filtered := *request
+	filtered.SMSRecipients = []SMSRecipients{}
+
+	failed := []SMSSendResponseItem{}
+	optedOut := []string{}
+
+	for _, r := range request.SMSRecipients {
+		item, ok := checked[r.To]
+
+		switch {
+		case !ok || !item.Successful():
+			failed = append(failed, SMSSendResponseItem{
+				To:             r.To,
+				HttpStatusCode: checkStatus(item.HttpStatusCode),
+				ErrorMessage:   strings.TrimSuffix("opt-out status could not be checked: "+item.ErrorMessage, ": "),
+			})
+		case item.IsOptedOut:
+			optedOut = append(optedOut, r.To)
+		default:
+			filtered.SMSRecipients = append(filtered.SMSRecipients, r)
+		}
+	}
+
+	return &filtered, failed, optedOut, nil
+}
+
+// checkStatus returns the status code of a check, or 500 if the service returned none.
+func checkStatus(code int) int {
+	if code == 0 {
+		return http.StatusInternalServerError
+	}
+
+	return code
+}
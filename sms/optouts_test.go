@@ -0,0 +1,146 @@
+package sms_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/sms"
+)
+
+// optOutServer is a fake of the SMS and opt-out endpoints.
+// The handler runs concurrently for the batches of SendSMS.
+type optOutServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	optedOut map[string]bool
+	sent     []string
+}
+
+func newOptOutServer(t *testing.T, optedOut ...string) *optOutServer {
+	s := &optOutServer{optedOut: map[string]bool{}}
+	for _, to := range optedOut {
+		s.optedOut[to] = true
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.URL.Path == "/sms" {
+			req := sms.Request{}
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			res := sms.Response{}
+			for _, recipient := range req.SMSRecipients {
+				s.sent = append(s.sent, recipient.To)
+				res.Value = append(res.Value, sms.SMSSendResponseItem{To: recipient.To, Successful: true, HttpStatusCode: http.StatusAccepted})
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(res)
+
+			return
+		}
+
+		assert.Equal(t, sms.DefaultOptOutVersion, r.URL.Query().Get("api-version"))
+
+		req := sms.OptOutRequest{}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, "+14255550100", req.From)
+
+		res := sms.OptOutResponse{}
+		for _, recipient := range req.Recipients {
+			item := sms.OptOutResponseItem{To: recipient.To, HttpStatusCode: http.StatusOK}
+
+			switch r.URL.Path {
+			case "/sms/optouts:add":
+				s.optedOut[recipient.To] = true
+			case "/sms/optouts:remove":
+				delete(s.optedOut, recipient.To)
+			case "/sms/optouts:check":
+				item.IsOptedOut = s.optedOut[recipient.To]
+			default:
+				assert.Failf(t, "unexpected path", "%s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			res.Value = append(res.Value, item)
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Sent returns the recipients of the sent SMS.
+func (s *optOutServer) Sent() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.sent)
+}
+
+func TestService_OptOuts(t *testing.T) {
+	srv := newOptOutServer(t)
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+	ctx := context.Background()
+
+	res, err := client.SMS.AddOptOuts(ctx, sms.NewOptOutRequest("+14255550100", "+14255550123"))
+	require.NoError(t, err)
+	require.True(t, res.Value[0].Successful())
+
+	res, err = client.SMS.CheckOptOuts(ctx, sms.NewOptOutRequest("+14255550100", "+14255550123", "+14255550124"))
+	require.NoError(t, err)
+	require.True(t, res.Value[0].IsOptedOut)
+	require.False(t, res.Value[1].IsOptedOut)
+
+	_, err = client.SMS.RemoveOptOuts(ctx, sms.NewOptOutRequest("+14255550100", "+14255550123"))
+	require.NoError(t, err)
+
+	res, err = client.SMS.CheckOptOuts(ctx, sms.NewOptOutRequest("+14255550100", "+14255550123"))
+	require.NoError(t, err)
+	require.False(t, res.Value[0].IsOptedOut)
+}
+
+func TestService_SendSMS_OptOutFilter(t *testing.T) {
+	srv := newOptOutServer(t, "+14255550123", "+14255550126")
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithSMSOptions(sms.WithOptOutFilter(), sms.WithBatchSize(1)))
+
+	res, err := client.SMS.SendSMS(context.Background(), &sms.Request{
+		From:    "+14255550100",
+		Message: "Hello",
+		SMSRecipients: []sms.SMSRecipients{
+			{To: "+14255550123"},
+			{To: "+14255550124"},
+			{To: "+14255550125"},
+			{To: "+14255550126"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"+14255550123", "+14255550126"}, res.OptedOut)
+	require.ElementsMatch(t, []string{"+14255550124", "+14255550125"}, srv.Sent())
+	require.Len(t, res.Successful(), 2)
+}
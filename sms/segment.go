@@ -0,0 +1,283 @@
+package sms
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf16"
+)
+
+// Encoding is the encoding of an SMS message.
+type Encoding string
+
+const (
+	// EncodingGSM7 is the GSM 03.38 7-bit default alphabet.
+	EncodingGSM7 Encoding = "GSM-7"
+	// EncodingUCS2 is the 16-bit encoding used for any message outside of GSM-7.
+	EncodingUCS2 Encoding = "UCS-2"
+)
+
+const (
+	// GSM7SingleSegmentLength is the number of septets in a single GSM-7 segment.
+	GSM7SingleSegmentLength = 160
+	// GSM7MultiSegmentLength is the number of septets in a segment of a concatenated GSM-7 message.
+	GSM7MultiSegmentLength = 153
+	// UCS2SingleSegmentLength is the number of code units in a single UCS-2 segment.
+	UCS2SingleSegmentLength = 70
+	// UCS2MultiSegmentLength is the number of code units in a segment of a concatenated UCS-2 message.
+	UCS2MultiSegmentLength = 67
+)
+
+// ErrSegmentBudgetExceeded is returned when a message does not fit into the segment budget.
+var ErrSegmentBudgetExceeded = errors.New("sms: message exceeds the segment budget")
+
+// gsm7Basic is the GSM 03.38 basic character set without the escape character.
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Extension is the GSM 03.38 extension table, each encoded with an escape septet.
+const gsm7Extension = "\f^{}\\[~]|€"
+
+// SegmentInfo is the result of analyzing a message.
+type SegmentInfo struct {
+	// Encoding is the encoding the message is sent with.
+	Encoding Encoding
+	// Length is the length of the message in septets for GSM-7,
+	// or in 16-bit code units for UCS-2.
+	Length int
+	// Segments is the number of segments the message is billed as.
+	Segments int
+	// CharactersPerSegment is the number of septets or code units that fit in a segment of the message.
+	CharactersPerSegment int
+	// Remaining is the number of septets or code units left in the last segment.
+	Remaining int
+	// NonGSMCharacters are the characters that forced the message into UCS-2.
+	NonGSMCharacters []rune
+}
+
+// Analyze returns the encoding and segment count of a message.
+func Analyze(message string) SegmentInfo {
+	info := SegmentInfo{Encoding: EncodingGSM7}
+
+	for _, r := range message {
+		if runeLength(r, EncodingGSM7) == 0 {
+			info.Encoding = EncodingUCS2
+			info.NonGSMCharacters = append(info.NonGSMCharacters, r)
+		}
+	}
+
+	single, multi := segmentLengths(info.Encoding)
+
+	for _, r := range message {
+		info.Length += runeLength(r, info.Encoding)
+	}
+
+	if info.Length <= single {
+		info.Segments = 1
+		info.CharactersPerSegment = single
+		info.Remaining = single - info.Length
+
+		if info.Length == 0 {
+			info.Segments = 0
+		}
+
+		return info
+	}
+
+	// Escaped characters and surrogate pairs are never split across
+	// segments, so the segments are filled character by character.
+	info.Segments = 1
+	info.CharactersPerSegment = multi
+	used := 0
+
+	for _, r := range message {
+		n := runeLength(r, info.Encoding)
+		if used+n > multi {
+			info.Segments++
+			used = 0
+		}
+		used += n
+	}
+
+	info.Remaining = multi - used
+
+	return info
+}
+
+// IsGSM7 returns true if the message can be encoded with the GSM-7 alphabet.
+func IsGSM7(message string) bool {
+	for _, r := range message {
+		if runeLength(r, EncodingGSM7) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Truncate cuts the message at a character boundary so that it fits into maxSegments.
+func Truncate(message string, maxSegments int) string {
+	if maxSegments <= 0 {
+		return ""
+	}
+
+	info := Analyze(message)
+	if info.Segments <= maxSegments {
+		return message
+	}
+
+	single, multi := segmentLengths(info.Encoding)
+
+	if maxSegments == 1 {
+		return cut(message, info.Encoding, single, 1)
+	}
+
+	return cut(message, info.Encoding, multi, maxSegments)
+}
+
+// cut returns the longest prefix of the message that fits into n segments of the given length.
+func cut(message string, enc Encoding, length, n int) string {
+	segments, used := 1, 0
+
+	for i, r := range message {
+		l := runeLength(r, enc)
+		if used+l > length {
+			if segments == n {
+				return message[:i]
+			}
+			segments++
+			used = 0
+		}
+		used += l
+	}
+
+	return message
+}
+
+// transliterations maps common characters outside of GSM-7 to their closest GSM-7 equivalent.
+var transliterations = map[rune]string{
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'", '`': "'", '´': "'",
+	'“': "\"", '”': "\"", '„': "\"", '‟': "\"", '″': "\"", '«': "\"", '»': "\"",
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'…': "...", '•': "*", '·': ".", '\t': " ",
+	'\u00a0': " ", '\u2002': " ", '\u2003': " ", '\u2009': " ", '\u202f': " ",
+	'\u200b': "", '\u200c': "", '\u200d': "", '\ufe0e': "", '\ufe0f': "",
+	'©': "(c)", '®': "(r)", '™': "TM", '×': "x", '÷': "/",
+	'á': "a", 'â': "a", 'ã': "a", 'ą': "a", 'ă': "a", 'ā': "a",
+	'Á': "A", 'À': "A", 'Â': "A", 'Ã': "A", 'Ą': "A", 'Ă': "A", 'Ā': "A",
+	'ç': "c", 'ć': "c", 'č': "c", 'Ć': "C", 'Č': "C",
+	'ď': "d", 'Ď': "D", 'đ': "d", 'Đ': "D",
+	'ê': "e", 'ë': "e", 'ę': "e", 'ě': "e", 'ē': "e",
+	'È': "E", 'Ê': "E", 'Ë': "E", 'Ę': "E", 'Ě': "E", 'Ē': "E",
+	'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'Í': "I", 'Ì': "I", 'Î': "I", 'Ï': "I", 'Ī': "I",
+	'ł': "l", 'Ł': "L",
+	'ń': "n", 'ň': "n", 'Ń': "N", 'Ň': "N",
+	'ó': "o", 'ô': "o", 'õ': "o", 'ő': "o", 'ō': "o",
+	'Ó': "O", 'Ò': "O", 'Ô': "O", 'Õ': "O", 'Ő': "O", 'Ō': "O",
+	'œ': "oe", 'Œ': "OE",
+	'ř': "r", 'Ř': "R",
+	'ś': "s", 'š': "s", 'ş': "s", 'Ś': "S", 'Š': "S", 'Ş': "S",
+	'ť': "t", 'Ť': "T",
+	'ú': "u", 'û': "u", 'ů': "u", 'ű': "u", 'ū': "u",
+	'Ú': "U", 'Ù': "U", 'Û': "U", 'Ů': "U", 'Ű': "U", 'Ū': "U",
+	'ý': "y", 'ÿ': "y", 'Ý': "Y", 'Ÿ': "Y",
+	'ź': "z", 'ż': "z", 'ž': "z", 'Ź': "Z", 'Ż': "Z", 'Ž': "Z",
+}
+
+// Transliterate replaces characters outside of GSM-7 with their closest GSM-7 equivalent.
+// Characters without an equivalent, like emoji, are replaced with a question mark.
+func Transliterate(message string) string {
+	var b strings.Builder
+
+	for _, r := range message {
+		if runeLength(r, EncodingGSM7) > 0 {
+			b.WriteRune(r)
+			continue
+		}
+
+		if s, ok := transliterations[r]; ok {
+			b.WriteString(s)
+			continue
+		}
+
+		b.WriteRune('?')
+	}
+
+	return b.String()
+}
+
+// FitOpt is the option for fitting a message into a segment budget.
+type FitOpt func(*fit)
+
+type fit struct {
+	transliterate bool
+	truncate      bool
+}
+
+// WithTransliteration transliterates the message to GSM-7 if it exceeds the budget.
+func WithTransliteration() FitOpt {
+	return func(f *fit) {
+		f.transliterate = true
+	}
+}
+
+// WithTruncation truncates the message if it still exceeds the budget.
+func WithTruncation() FitOpt {
+	return func(f *fit) {
+		f.truncate = true
+	}
+}
+
+// Fit makes the message fit into maxSegments. Without options the message is only checked.
+// Transliteration is tried before truncation, so that as much of the message as possible is kept.
+func Fit(message string, maxSegments int, opts ...FitOpt) (string, SegmentInfo, error) {
+	f := &fit{}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	info := Analyze(message)
+	if info.Segments <= maxSegments {
+		return message, info, nil
+	}
+
+	if f.transliterate && info.Encoding == EncodingUCS2 {
+		message = Transliterate(message)
+
+		info = Analyze(message)
+		if info.Segments <= maxSegments {
+			return message, info, nil
+		}
+	}
+
+	if f.truncate {
+		message = Truncate(message, maxSegments)
+		return message, Analyze(message), nil
+	}
+
+	return message, info, ErrSegmentBudgetExceeded
+}
+
+// runeLength returns the length of a character in the given encoding,
+// or 0 if the character can not be encoded with GSM-7.
+func runeLength(r rune, enc Encoding) int {
+	if enc == EncodingUCS2 {
+		return len(utf16.Encode([]rune{r}))
+	}
+
+	switch {
+	case strings.ContainsRune(gsm7Basic, r):
+		return 1
+	case strings.ContainsRune(gsm7Extension, r):
+		return 2
+	}
+
+	return 0
+}
+
+func segmentLengths(enc Encoding) (int, int) {
+	if enc == EncodingUCS2 {
+		return UCS2SingleSegmentLength, UCS2MultiSegmentLength
+	}
+
+	return GSM7SingleSegmentLength, GSM7MultiSegmentLength
+}
@@ -0,0 +1,89 @@
+package sms_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs/sms"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		encoding  sms.Encoding
+		length    int
+		segments  int
+		perSeg    int
+		remaining int
+	}{
+		{name: "empty", message: "", encoding: sms.EncodingGSM7, length: 0, segments: 0, perSeg: 160, remaining: 160},
+		{name: "single gsm7", message: "Hello world", encoding: sms.EncodingGSM7, length: 11, segments: 1, perSeg: 160, remaining: 149},
+		{name: "full gsm7", message: strings.Repeat("a", 160), encoding: sms.EncodingGSM7, length: 160, segments: 1, perSeg: 160, remaining: 0},
+		{name: "two gsm7", message: strings.Repeat("a", 161), encoding: sms.EncodingGSM7, length: 161, segments: 2, perSeg: 153, remaining: 145},
+		{name: "extended gsm7", message: "Price: 10€ [sale]", encoding: sms.EncodingGSM7, length: 20, segments: 1, perSeg: 160, remaining: 140},
+		{name: "extended at boundary", message: strings.Repeat("a", 152) + "€" + "a", encoding: sms.EncodingGSM7, length: 155, segments: 1, perSeg: 160, remaining: 5},
+		{name: "extended not split", message: strings.Repeat("a", 152) + "€" + strings.Repeat("a", 10), encoding: sms.EncodingGSM7, length: 164, segments: 2, perSeg: 153, remaining: 141},
+		{name: "ucs2", message: "Grüße ✓", encoding: sms.EncodingUCS2, length: 7, segments: 1, perSeg: 70, remaining: 63},
+		{name: "emoji", message: "Sale 🎉", encoding: sms.EncodingUCS2, length: 7, segments: 1, perSeg: 70, remaining: 63},
+		{name: "two ucs2", message: strings.Repeat("✓", 71), encoding: sms.EncodingUCS2, length: 71, segments: 2, perSeg: 67, remaining: 63},
+		{name: "surrogate not split", message: strings.Repeat("a", 66) + "🎉" + strings.Repeat("a", 10), encoding: sms.EncodingUCS2, length: 78, segments: 2, perSeg: 67, remaining: 55},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := sms.Analyze(tt.message)
+			require.Equal(t, tt.encoding, info.Encoding)
+			require.Equal(t, tt.length, info.Length)
+			require.Equal(t, tt.segments, info.Segments)
+			require.Equal(t, tt.perSeg, info.CharactersPerSegment)
+			require.Equal(t, tt.remaining, info.Remaining)
+		})
+	}
+}
+
+func TestAnalyze_NonGSMCharacters(t *testing.T) {
+	info := sms.Analyze("Hi 🎉 “you”")
+	require.Equal(t, []rune{'🎉', '“', '”'}, info.NonGSMCharacters)
+	require.False(t, sms.IsGSM7("Hi 🎉"))
+	require.True(t, sms.IsGSM7("Hi {you}"))
+}
+
+func TestTransliterate(t *testing.T) {
+	require.Equal(t, `"Smart" quotes - and... facade ?`, sms.Transliterate("“Smart” quotes – and… façade 🎉"))
+	require.True(t, sms.IsGSM7(sms.Transliterate("Zażółć gęślą jaźń")))
+}
+
+func TestTruncate(t *testing.T) {
+	msg := strings.Repeat("a", 400)
+
+	require.Len(t, sms.Truncate(msg, 1), 160)
+	require.Len(t, sms.Truncate(msg, 2), 306)
+	require.Equal(t, msg, sms.Truncate(msg, 3))
+
+	emoji := strings.Repeat("🎉", 40)
+	truncated := sms.Truncate(emoji, 1)
+	require.Equal(t, strings.Repeat("🎉", 35), truncated)
+}
+
+func TestFit(t *testing.T) {
+	msg := strings.Repeat("Great deal – 50% off! ", 4)
+
+	_, info, err := sms.Fit(msg, 1)
+	require.ErrorIs(t, err, sms.ErrSegmentBudgetExceeded)
+	require.Equal(t, sms.EncodingUCS2, info.Encoding)
+	require.Equal(t, 2, info.Segments)
+
+	fitted, info, err := sms.Fit(msg, 1, sms.WithTransliteration())
+	require.NoError(t, err)
+	require.Equal(t, sms.EncodingGSM7, info.Encoding)
+	require.Equal(t, 1, info.Segments)
+	require.Equal(t, strings.Repeat("Great deal - 50% off! ", 4), fitted)
+
+	long := strings.Repeat("🎉", 100)
+	fitted, info, err = sms.Fit(long, 1, sms.WithTruncation())
+	require.NoError(t, err)
+	require.Equal(t, 1, info.Segments)
+	require.Equal(t, strings.Repeat("🎉", 35), fitted)
+}
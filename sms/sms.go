@@ -2,12 +2,35 @@ package sms
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/zeiss/carry"
+	"github.com/zeiss/go-acs/internal/httpx"
+	"github.com/zeiss/go-acs/internal/repeatability"
+	"github.com/zeiss/go-acs/phonenumbers"
 )
 
+// DefaultVersion is the default API version of the SMS service.
 const DefaultVersion = "2021-03-07"
 
+// MaxRecipientsPerRequest is the maximum number of recipients ACS accepts in a single request.
+const MaxRecipientsPerRequest = 100
+
+// DefaultConcurrency is the default number of requests that are sent concurrently.
+const DefaultConcurrency = 4
+
+// ErrNoRecipients is returned when a request has no recipients.
+var ErrNoRecipients = errors.New("sms: request has no recipients")
+
+// Version is the API version query of the SMS service.
+type Version struct {
+	APIVersion string `url:"api-version"`
+}
+
 // Request is the request for sending an SMS.
 type Request struct {
 	// From is the phone number of the sender formatted as E.164 format.
@@ -20,14 +43,48 @@ type Request struct {
 	SMSSendOptions SMSSendOptions `json:"smsSendOptions"`
 }
 
+// Segments returns the encoding and segment count of the message.
+// Each recipient is billed for every segment.
+func (r *Request) Segments() SegmentInfo {
+	return Analyze(r.Message)
+}
+
+// Validate validates the recipients and phone numbers of the request.
+// The sender may also be a short code or an alphanumeric sender ID,
+// so it is only validated if it is formatted as E.164.
+func (r *Request) Validate() error {
+	errs := []error{}
+
+	if len(r.SMSRecipients) == 0 {
+		errs = append(errs, ErrNoRecipients)
+	}
+
+	if strings.HasPrefix(r.From, "+") {
+		errs = append(errs, phonenumbers.E164(r.From).Validate())
+	}
+
+	for _, recipient := range r.SMSRecipients {
+		errs = append(errs, phonenumbers.E164(recipient.To).Validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewRecipient returns a recipient for the phone number.
+func NewRecipient(to phonenumbers.E164) SMSRecipients {
+	return SMSRecipients{To: to.String()}
+}
+
 // SMSRecipients is the recipients of the SMS request.
 type SMSRecipients struct {
 	// To is the phone number of the recipient.
 	To string `json:"to"`
 	// RepeatabilityRequestID is the ID of the request.
-	RepeatabilityRequestID string `json:"repeatabilityRequestId"`
+	// It is derived from the repeatability of the context when sending, if it is empty.
+	RepeatabilityRequestID string `json:"repeatabilityRequestId,omitempty"`
 	// RepeatabilityFirstSent is the time the request was first sent.
-	RepeatabilityFirstSent string `json:"repeatabilityFirstSent"`
+	// It is taken from the repeatability of the context when sending, if it is empty.
+	RepeatabilityFirstSent string `json:"repeatabilityFirstSent,omitempty"`
 }
 
 // SMSSendOptions is the options for sending the SMS request.
@@ -35,12 +92,15 @@ type SMSSendOptions struct {
 	// EnableDeliveryReport is whether to enable delivery reports.
 	EnableDeliveryReport bool `json:"enableDeliveryReport"`
 	// Tag is the tag for the request.
-	Tag string `json:"tag"`
+	Tag string `json:"tag,omitempty"`
 }
 
 // Response is the response for sending an SMS.
 type Response struct {
 	Value []SMSSendResponseItem `json:"value"`
+	// OptedOut are the recipients that were not sent to, because they opted out.
+	// It is only set if the service filters opt-outs.
+	OptedOut []string `json:"-"`
 }
 
 // SMSSendResponseItem is the response item for sending an SMS.
@@ -59,6 +119,60 @@ type SMSSendResponseItem struct {
 	RepeatabilityResult RepeatabilityResult `json:"repeatabilityResult"`
 }
 
+// Successful returns the items of the recipients the message was sent to.
+func (r *Response) Successful() []SMSSendResponseItem {
+	items := []SMSSendResponseItem{}
+
+	for _, item := range r.Value {
+		if item.Successful {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// Failed returns the items of the recipients the message could not be sent to.
+func (r *Response) Failed() []SMSSendResponseItem {
+	items := []SMSSendResponseItem{}
+
+	for _, item := range r.Value {
+		if !item.Successful {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// Retryable returns the failed items that can be sent again.
+func (r *Response) Retryable() []SMSSendResponseItem {
+	items := []SMSSendResponseItem{}
+
+	for _, item := range r.Value {
+		if item.Retryable() {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// Retryable returns true if the send failed for a reason that may go away,
+// like throttling, a service outage or a network error.
+func (i SMSSendResponseItem) Retryable() bool {
+	if i.Successful {
+		return false
+	}
+
+	switch i.HttpStatusCode {
+	case 0, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+
+	return i.HttpStatusCode >= http.StatusInternalServerError
+}
+
 // RepeatabilityResult is the result of a repeatability request.
 type RepeatabilityResult string
 
@@ -69,27 +183,234 @@ const (
 	RepeatabilityResultRejected RepeatabilityResult = "rejected"
 )
 
-// Error is the error for the SMS API.
-type Error struct{}
-
 // Service is the service for the SMS API.
 type Service struct {
-	client *carry.Client
+	client        *carry.Client
+	doer          carry.Doer
+	version       *Version
+	optOutVersion *Version
+	batchSize     int
+	concurrency   int
+	optOutFilter  bool
+}
+
+// Opt is the option for the SMS service.
+type Opt func(*Service)
+
+// WithAPIVersion sets the API version of the SMS service.
+func WithAPIVersion(version string) Opt {
+	return func(s *Service) {
+		s.version = &Version{APIVersion: version}
+	}
+}
+
+// WithOptOutAPIVersion sets the API version of the opt-out endpoints.
+func WithOptOutAPIVersion(version string) Opt {
+	return func(s *Service) {
+		s.optOutVersion = &Version{APIVersion: version}
+	}
+}
+
+// WithOptOutFilter checks the recipients for opt-outs before sending
+// and drops the ones that opted out.
+func WithOptOutFilter() Opt {
+	return func(s *Service) {
+		s.optOutFilter = true
+	}
+}
+
+// WithDoer sets the doer that sends the requests of the SMS service.
+// The client passed to NewService only builds the requests, and its doer is not used.
+// By default the requests are sent with the http.DefaultClient.
+func WithDoer(doer carry.Doer) Opt {
+	return func(s *Service) {
+		s.doer = doer
+	}
+}
+
+// WithBatchSize sets the number of recipients that are sent in a single request.
+// It is capped at MaxRecipientsPerRequest.
+func WithBatchSize(size int) Opt {
+	return func(s *Service) {
+		s.batchSize = size
+	}
+}
+
+// WithConcurrency sets the number of requests that are sent concurrently.
+func WithConcurrency(n int) Opt {
+	return func(s *Service) {
+		s.concurrency = n
+	}
 }
 
 // NewService returns a new SmsService
-func NewService(c *carry.Client) *Service {
-	return &Service{c}
+func NewService(c *carry.Client, opts ...Opt) *Service {
+	s := &Service{
+		client:        c,
+		doer:          http.DefaultClient,
+		version:       &Version{APIVersion: DefaultVersion},
+		optOutVersion: &Version{APIVersion: DefaultOptOutVersion},
+		batchSize:     MaxRecipientsPerRequest,
+		concurrency:   DefaultConcurrency,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // SendSMS sends an SMS message.
+//
+// The request is validated before anything is sent. Recipient lists that
+// exceed the batch size are split into batches, which are sent concurrently.
+// The results of all batches are merged into one response.
+// When a batch fails as a whole, its recipients are reported as failed items.
+// An error is only returned if no batch could be sent.
+//
+// With the opt-out filter, recipients that opted out are dropped and reported
+// in Response.OptedOut.
 func (s *Service) SendSMS(ctx context.Context, request *Request) (*Response, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Response{}
+
+	if s.optOutFilter {
+		filtered, failed, optedOut, err := s.filterOptOuts(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		request = filtered
+		result.Value = failed
+		result.OptedOut = optedOut
+
+		if len(request.SMSRecipients) == 0 {
+			return result, nil
+		}
+	}
+
+	// Every batch is a request of its own, so each needs its own repeatability.
+	r, ok := repeatability.FromContext(ctx)
+	if !ok {
+		r = repeatability.New()
+	}
+
+	batches := s.batches(request, r)
+	results := make([]*Response, len(batches))
+	errs := make([]error, len(batches))
+
+	sem := make(chan struct{}, max(s.concurrency, 1))
+	var wg sync.WaitGroup
+
+	for i, batch := range batches {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			results[i], errs[i] = s.send(repeatability.WithContext(ctx, r.Derive(i)), batch)
+		}()
+	}
+
+	wg.Wait()
+
+	failed := 0
+
+	for i, batch := range batches {
+		if errs[i] == nil {
+			result.Value = append(result.Value, results[i].Value...)
+			continue
+		}
+
+		failed++
+
+		for _, r := range batch.SMSRecipients {
+			result.Value = append(result.Value, SMSSendResponseItem{
+				To:             r.To,
+				ErrorMessage:   errs[i].Error(),
+				HttpStatusCode: statusCode(errs[i]),
+			})
+		}
+	}
+
+	if failed == len(batches) {
+		return nil, errors.Join(errs...)
+	}
+
+	return result, nil
+}
+
+// send sends a single batch.
+func (s *Service) send(ctx context.Context, request *Request) (*Response, error) {
 	result := &Response{}
 
-	_, err := s.client.New().Post("/sms").ReceiveSuccess(ctx, result)
+	req := repeatability.Set(ctx, s.client.New().Post("/sms").QueryStruct(s.version).BodyJSON(request))
+
+	_, err := httpx.Receive(ctx, s.doer, req, result)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, err
+	return result, nil
+}
+
+// batches splits the request into batches and fills in the repeatability
+// of the recipients, so that resending a batch never sends a message twice.
+// The repeatability of a recipient is derived from r and its phone number,
+// so that resending the request with the same r gives it the same values again.
+func (s *Service) batches(request *Request, r repeatability.Repeatability) []*Request {
+	size := s.batchSize
+	if size <= 0 || size > MaxRecipientsPerRequest {
+		size = MaxRecipientsPerRequest
+	}
+
+	if r.FirstSent.IsZero() {
+		r.FirstSent = time.Now()
+	}
+
+	firstSent := r.FirstSent.UTC().Format(http.TimeFormat)
+
+	recipients := make([]SMSRecipients, len(request.SMSRecipients))
+	for i, recipient := range request.SMSRecipients {
+		if recipient.RepeatabilityRequestID == "" {
+			recipient.RepeatabilityRequestID = r.DeriveKey(recipient.To).RequestID
+		}
+
+		if recipient.RepeatabilityFirstSent == "" {
+			recipient.RepeatabilityFirstSent = firstSent
+		}
+
+		recipients[i] = recipient
+	}
+
+	batches := []*Request{}
+	for start := 0; start < len(recipients); start += size {
+		batch := *request
+		batch.SMSRecipients = recipients[start:min(start+size, len(recipients))]
+		batches = append(batches, &batch)
+	}
+
+	return batches
+}
+
+// statusCode returns the HTTP status code of an error, if it carries one.
+func statusCode(err error) int {
+	var e interface{ HTTPStatusCode() int }
+	if errors.As(err, &e) {
+		return e.HTTPStatusCode()
+	}
+
+	return 0
 }
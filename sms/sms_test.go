@@ -0,0 +1,185 @@
+package sms_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeiss/go-acs"
+	"github.com/zeiss/go-acs/phonenumbers"
+	"github.com/zeiss/go-acs/sms"
+)
+
+func TestService_SendSMS(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		req := sms.Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "Hello", req.Message)
+		require.LessOrEqual(t, len(req.SMSRecipients), 2)
+
+		if req.SMSRecipients[0].To == "+15550000003" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		res := sms.Response{}
+		for _, recipient := range req.SMSRecipients {
+			require.NotEmpty(t, recipient.RepeatabilityRequestID)
+			require.NotEmpty(t, recipient.RepeatabilityFirstSent)
+
+			item := sms.SMSSendResponseItem{To: recipient.To, Successful: true, HttpStatusCode: http.StatusAccepted, MessageID: "id-" + recipient.To}
+			if strings.HasSuffix(recipient.To, "2") {
+				item = sms.SMSSendResponseItem{To: recipient.To, HttpStatusCode: http.StatusBadRequest, ErrorMessage: "Invalid To phone number format."}
+			}
+
+			res.Value = append(res.Value, item)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(),
+		acs.WithRetryPolicy(acs.NoRetryPolicy),
+		acs.WithSMSOptions(sms.WithBatchSize(2), sms.WithConcurrency(2)),
+	)
+
+	res, err := client.SMS.SendSMS(context.Background(), &sms.Request{
+		From:    "+15550000000",
+		Message: "Hello",
+		SMSRecipients: []sms.SMSRecipients{
+			{To: "+15550000001"},
+			{To: "+15550000002"},
+			{To: "+15550000003"},
+			{To: "+15550000004"},
+			{To: "+15550000005"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Value, 5)
+	require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+
+	successful := res.Successful()
+	require.Len(t, successful, 2)
+	require.Equal(t, "+15550000001", successful[0].To)
+	require.Equal(t, "id-+15550000005", successful[1].MessageID)
+
+	failed := res.Failed()
+	require.Len(t, failed, 3)
+
+	retryable := res.Retryable()
+	require.Len(t, retryable, 2)
+	require.Equal(t, "+15550000003", retryable[0].To)
+	require.Equal(t, "+15550000004", retryable[1].To)
+	require.Equal(t, http.StatusServiceUnavailable, retryable[0].HttpStatusCode)
+}
+
+func TestService_SendSMS_Failed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client())
+
+	_, err := client.SMS.SendSMS(context.Background(), &sms.Request{
+		SMSRecipients: []sms.SMSRecipients{{To: "+15550000001"}},
+	})
+	require.True(t, acs.IsUnauthorized(err))
+
+	_, err = client.SMS.SendSMS(context.Background(), &sms.Request{})
+	require.ErrorIs(t, err, sms.ErrNoRecipients)
+}
+
+func TestService_SendSMS_Repeatability(t *testing.T) {
+	var mu sync.Mutex
+	recipients := map[string][]sms.SMSRecipients{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := sms.Request{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		for _, recipient := range req.SMSRecipients {
+			recipients[recipient.To] = append(recipients[recipient.To], recipient)
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(sms.Response{})
+	}))
+	defer srv.Close()
+
+	client := acs.New(srv.URL, "c2VjcmV0", srv.Client(), acs.WithSMSOptions(sms.WithBatchSize(1)))
+
+	req := &sms.Request{
+		From:          "+15550000000",
+		Message:       "Hello",
+		SMSRecipients: []sms.SMSRecipients{{To: "+15550000001"}, {To: "+15550000002"}},
+	}
+
+	ctx := acs.ContextWithRepeatability(context.Background(), acs.NewRepeatability())
+
+	// Resending the same request with the same repeatability sends the same values again.
+	for range 2 {
+		_, err := client.SMS.SendSMS(ctx, req)
+		require.NoError(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(t, recipients, 2)
+	require.NotEqual(t, recipients["+15550000001"][0].RepeatabilityRequestID, recipients["+15550000002"][0].RepeatabilityRequestID)
+
+	for _, sent := range recipients {
+		require.Len(t, sent, 2)
+		require.NotEmpty(t, sent[0].RepeatabilityRequestID)
+		require.Equal(t, sent[0].RepeatabilityRequestID, sent[1].RepeatabilityRequestID)
+		require.Equal(t, sent[0].RepeatabilityFirstSent, sent[1].RepeatabilityFirstSent)
+	}
+
+	require.Empty(t, req.SMSRecipients[0].RepeatabilityRequestID)
+}
+
+func TestRequest_Validate(t *testing.T) {
+	req := &sms.Request{
+		From:          "+14255550100",
+		SMSRecipients: []sms.SMSRecipients{sms.NewRecipient(phonenumbers.MustParse("(425) 555-0123", "US"))},
+	}
+	require.NoError(t, req.Validate())
+	require.Equal(t, "+14255550123", req.SMSRecipients[0].To)
+
+	req.From = "CONTOSO"
+	require.NoError(t, req.Validate())
+
+	req.SMSRecipients = append(req.SMSRecipients, sms.SMSRecipients{To: "0171 1234567"})
+	require.ErrorIs(t, req.Validate(), phonenumbers.ErrMissingRegion)
+
+	req.From = "+1425"
+	require.ErrorIs(t, req.Validate(), phonenumbers.ErrTooShort)
+}
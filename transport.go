@@ -0,0 +1,114 @@
+package acs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zeiss/carry"
+)
+
+type apiVersionKey struct{}
+
+// ContextWithAPIVersion returns a context that overrides the api-version
+// of every request made with it, regardless of the service default.
+func ContextWithAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionKey{}, version)
+}
+
+// APIVersionFromContext returns the api-version override of the context.
+func APIVersionFromContext(ctx context.Context) (string, bool) {
+	version, ok := ctx.Value(apiVersionKey{}).(string)
+	return version, ok && version != ""
+}
+
+// transport is the request pipeline of the client.
+// It applies the per-call options, retries
+// failed attempts, signs each attempt right before it is sent and turns
+// failed responses into a *ResponseError.
+type transport struct {
+	doer   carry.Doer
+	signer carry.SignerProvider
+	retry  RetryPolicy
+}
+
+// Do sends the request.
+func (t *transport) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if version, ok := APIVersionFromContext(ctx); ok {
+		q := req.URL.Query()
+		q.Set("api-version", version)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	// The HMAC signer hashes the body, which requires a body to be present.
+	if req.GetBody == nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			return http.NoBody, nil
+		}
+	}
+
+	// The services set the repeatability headers while building the request,
+	// so that every attempt reuses them.
+	retryable := isRetryable(req)
+
+	for attempt := 1; ; attempt++ {
+		res, err := t.attempt(req)
+
+		delay, ok := t.retryDelay(req, attempt, retryable, res, err)
+		if !ok {
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode < 200 || res.StatusCode > 299 {
+				return nil, newResponseError(res)
+			}
+
+			return res, nil
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns the time to wait before the next attempt,
+// or false if the result of the attempt is final.
+func (t *transport) retryDelay(req *http.Request, attempt int, retryable bool, res *http.Response, err error) (time.Duration, bool) {
+	if attempt >= t.retry.MaxAttempts || !retryable || req.Context().Err() != nil || !t.retry.shouldRetry(res, err) {
+		return 0, false
+	}
+
+	return t.retry.delay(attempt, res)
+}
+
+// attempt signs and sends a copy of the request with a fresh body.
+func (t *transport) attempt(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = body
+
+	err = t.signer.Sign(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.doer.Do(r)
+}